@@ -0,0 +1,9 @@
+package collection
+
+// Number is a constraint that permits any integer or floating-point type,
+// including named types whose underlying type is one of them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
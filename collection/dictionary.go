@@ -1,5 +1,13 @@
 package collection
 
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+)
+
 // Dictionary is a generic key-value store where each key is of type K and each value is of type V.
 // The Dictionary provides methods to manipulate and interact with key-value pairs efficiently, including
 // operations like adding, removing, and transforming pairs.
@@ -163,15 +171,15 @@ func (c *Dictionary[K, V]) Find(predicate func(K, V) bool) []V {
 //                The function should return true for the first pair that matches the search criteria.
 //
 // Returns:
-//   - A pointer to the value of type V if a matching key-value pair is found, or nil if not found.
+//   - A copy of the value of type V if a matching key-value pair is found, or the zero value if not found.
 //   - A boolean indicating whether a match was found (true if found, false otherwise).
 //
 // Example usage:
 //     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
 //     value, found := dict.FindOne(func(k string, v int) bool { return v == 2 })
-//     // value will be a pointer to 2, found will be true
+//     // value will be 2, found will be true
 //     value, found = dict.FindOne(func(k string, v int) bool { return v == 4 })
-//     // value will be nil, found will be false
+//     // value will be 0, found will be false
 func (c *Dictionary[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
 	for k, v := range c.items {
 		if predicate(k, v) {
@@ -182,6 +190,31 @@ func (c *Dictionary[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
 	return zero, false
 }
 
+// FindPair searches for the first key-value pair in the Dictionary that satisfies the given predicate function.
+// Unlike FindOne, it returns the matching key together with its value.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//                The function should return true for the first pair that matches the search criteria.
+//
+// Returns:
+//   - The matching Pair[K, V], or a Pair holding zero values if not found.
+//   - A boolean indicating whether a match was found (true if found, false otherwise).
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//     pair, found := dict.FindPair(func(k string, v int) bool { return v == 2 })
+//     // pair.Key() will be "b", pair.Value() will be 2, found will be true
+func (c *Dictionary[K, V]) FindPair(predicate func(K, V) bool) (Pair[K, V], bool) {
+	for k, v := range c.items {
+		if predicate(k, v) {
+			return NewPair(k, v), true
+		}
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
 // Get retrieves the value associated with the given key in the Dictionary.
 // It returns a pointer to the value if the key exists, and a boolean indicating whether the key was found.
 //
@@ -201,6 +234,27 @@ func (c *Dictionary[K, V]) Get(key K) (V, bool) {
 	return value, exists
 }
 
+// GetOrDefault retrieves the value associated with the given key in the Dictionary, or the fallback if the key does not exist.
+// It is a pure read: the fallback is never stored, so the Dictionary is left unmodified on a miss.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key does not exist.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1})
+//     value := dict.GetOrDefault("a", 0) // value will be 1
+//     value = dict.GetOrDefault("b", 0)  // value will be 0, dict still contains only "a"
+func (c *Dictionary[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, exists := c.items[key]; exists {
+		return value
+	}
+	return fallback
+}
+
 // Put adds a key-value pair to the Dictionary, updating the value if the key already exists.
 // It returns the old value associated with the key, if any, and a boolean indicating whether
 // the key already existed in the Dictionary (true if it existed, false otherwise).
@@ -247,6 +301,81 @@ func (c *Dictionary[K, V]) PutIfAbsent(key K, item V) (V, bool) {
 	return old, exists
 }
 
+// GetOrPut retrieves the value associated with the given key in the Dictionary. If the key does not exist,
+// the fallback is stored under the key and returned.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V stored and returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key was absent.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1})
+//     value := dict.GetOrPut("a", 0) // value will be 1, dict is unchanged
+//     value = dict.GetOrPut("b", 2)  // value will be 2, dict will contain {"a": 1, "b": 2}
+func (c *Dictionary[K, V]) GetOrPut(key K, fallback V) V {
+	if value, exists := c.items[key]; exists {
+		return value
+	}
+	c.items[key] = fallback
+	return fallback
+}
+
+// ComputeIfAbsent retrieves the value associated with the given key in the Dictionary. If the key does not exist,
+// the factory is invoked with the key and its result is stored and returned. The factory is not called when the key exists.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - factory: A function that builds the value of type V for a missing key.
+//
+// Returns:
+//   - The value associated with the key, or the value built by the factory if the key was absent.
+//   - A boolean indicating whether the factory ran, which tells a miss from a hit.
+//
+// Example usage:
+//     dict := DictionaryEmpty[string, *os.File]()
+//     file, computed := dict.ComputeIfAbsent("log", func(name string) *os.File { f, _ := os.Create(name); return f })
+//     // computed will be true on the first call and false afterwards
+func (c *Dictionary[K, V]) ComputeIfAbsent(key K, factory func(K) V) (V, bool) {
+	if value, exists := c.items[key]; exists {
+		return value, false
+	}
+	value := factory(key)
+	c.items[key] = value
+	return value, true
+}
+
+// Compute updates the value associated with the given key in the Dictionary in a single read-modify-write step.
+// The remap function receives the current value and whether the key exists, and returns the new value and whether
+// the key must be kept. Returning false removes the key, or leaves it absent if it did not exist.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be computed.
+//   - remap: A function that takes the key, the current value (the zero value if absent) and whether the key exists,
+//     and returns the new value of type V and whether to keep it.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value of type V if the key was removed.
+//   - A boolean indicating whether the key exists after the call.
+//
+// Example usage:
+//     dict := DictionaryEmpty[string, int]()
+//     dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return old + 1, true }) // dict will contain {"hits": 1}
+//     dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return 0, false })      // dict will be empty: {}
+func (c *Dictionary[K, V]) Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool) {
+	old, exists := c.items[key]
+	value, keep := remap(key, old, exists)
+	if !keep {
+		delete(c.items, key)
+		var zero V
+		return zero, false
+	}
+	c.items[key] = value
+	return value, true
+}
+
 // PutAll adds all key-value pairs from another map to the Dictionary
 // overwriting any existing values for the keys that already exist in the Dictionary.
 //
@@ -355,6 +484,27 @@ func (c *Dictionary[K, V]) Remove(key K) (V, bool) {
 	return old, exists
 }
 
+// PopItem removes an arbitrary key-value pair from the Dictionary and returns it.
+// Dictionaries have no specific order, so no guarantee is made about which pair is removed.
+//
+// Returns:
+//   - The removed Pair[K, V], or a Pair holding zero values if the Dictionary is empty.
+//   - A boolean indicating whether a pair was removed.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//     for pair, ok := dict.PopItem(); ok; pair, ok = dict.PopItem() {
+//         fmt.Println(pair.Key(), pair.Value())
+//     } // dict will be empty
+func (c *Dictionary[K, V]) PopItem() (Pair[K, V], bool) {
+	for key, value := range c.items {
+		delete(c.items, key)
+		return NewPair(key, value), true
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
 // ForEach iterates over all key-value pairs in the Dictionary, applying the provided predicate function to each pair.
 // The predicate is called with each key and value, allowing side effects or custom actions for every entry in the Dictionary.
 //
@@ -377,6 +527,21 @@ func (c *Dictionary[K, V]) ForEach(predicate func(K, V)) IDictionary[K, V] {
 	return c
 }
 
+// All returns an iterator over the key-value pairs of the Dictionary, to be used with range-over-func
+// and the iter and maps packages. The pairs are yielded in no specific order.
+//
+// Returns:
+//   - An iter.Seq2 yielding each key together with its value.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//     for k, v := range dict.All() {
+//         fmt.Println(k, v)
+//     }
+func (c *Dictionary[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(c.items)
+}
+
 // Map transforms the values in the Dictionary by applying the provided predicate function to each key-value pair.
 //
 // Parameters:
@@ -410,6 +575,26 @@ func (c *Dictionary[K, V]) Clean() IDictionary[K, V] {
 	return c
 }
 
+// CleanWith removes all key-value pairs from the Dictionary, calling the provided predicate function
+// with every entry before the contents are cleared. It is intended for releasing resources held as values.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and releases or processes the entry.
+//
+// Returns:
+//   - The Dictionary itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]*os.File{"log": file})
+//     dict.CleanWith(func(k string, f *os.File) { f.Close() }) // dict will be empty: {}
+func (c *Dictionary[K, V]) CleanWith(predicate func(K, V)) IDictionary[K, V] {
+	for k, v := range c.items {
+		predicate(k, v)
+	}
+	c.items = make(map[K]V)
+	return c
+}
+
 // Clone creates a shallow copy of the Dictionary, including all key-value pairs.
 // The new Dictionary will have the same keys and values as the original, but modifications to one
 // will not affect the other.
@@ -604,7 +789,8 @@ func (c *Dictionary[K, V]) Pairs() []Pair[K, V] {
 	return pairs
 }
 
-// Collect returns an intance map containing all the key-value pairs in the Dictionary.
+// Collect returns a new map instance containing all the key-value pairs in the Dictionary.
+// The map is a shallow copy, matching DictionarySync.Collect, so modifying it does not affect the Dictionary.
 //
 // Returns:
 //   - A map of type map[K]V containing all key-value pairs in the Dictionary.
@@ -613,7 +799,22 @@ func (c *Dictionary[K, V]) Pairs() []Pair[K, V] {
 //     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
 //     collectedMap := dict.Collect() // collectedMap will be map[string]int{"a": 1, "b": 2}
 func (c Dictionary[K, V]) Collect() map[K]V {
-	return c.items
+	return maps.Clone(c.items)
+}
+
+// Freeze returns a read-only view of the Dictionary. The view is backed by the same
+// items, so later changes to the Dictionary are visible through it, but the view itself
+// exposes no way to modify them.
+//
+// Returns:
+//   - An IReadDictionary[K, V] exposing only the read operations of the Dictionary.
+//
+// Example usage:
+//     dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//     frozen := dict.Freeze()
+//     value, exists := frozen.Get("a") // value will be 1, exists will be true
+func (c *Dictionary[K, V]) Freeze() IReadDictionary[K, V] {
+	return ImmutableDictionaryFrom[K, V](c)
 }
 
 // DictionaryMap creates a new Dictionary by applying the provided predicate function to each key-value pair in the original IDictionary.
@@ -693,3 +894,602 @@ func VectorMapToDictionary[K, V any, E comparable](c IVector[K], predicate func(
 func ListMapToDictionary[K, V any, E comparable](c []K, predicate func(K) (E, V)) IDictionary[E, V] {
 	return ListMapToIDictionary(c, predicate, MakeDictionary)
 }
+
+// DictionaryCountByValue counts how many keys map to each distinct value of the IDictionary.
+//
+// Parameters:
+//   - c: The IDictionary whose values will be counted.
+//
+// Returns:
+//   - A new Dictionary[V, int] mapping each distinct value to the number of keys holding it.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]string{"a": "x", "b": "y", "c": "x"})
+//	counts := DictionaryCountByValue(dict)
+//	// counts will contain {"x": 2, "y": 1}
+func DictionaryCountByValue[K comparable, V comparable](c IDictionary[K, V]) *Dictionary[V, int] {
+	counts := make(map[V]int)
+	for _, v := range c.Collect() {
+		counts[v]++
+	}
+	return DictionaryFromMap(counts)
+}
+
+// DictionaryEqualKeys reports whether two dictionaries hold exactly the same set of keys, ignoring their values.
+//
+// Parameters:
+//   - a: The first IDictionary to compare.
+//   - b: The second IDictionary to compare.
+//
+// Returns:
+//   - A boolean indicating whether both dictionaries have identical key sets.
+//
+// Example usage:
+//
+//	a := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	b := DictionaryFromMap(map[string]int{"a": 3, "b": 4})
+//	equal := DictionaryEqualKeys(a, b) // equal will be true
+func DictionaryEqualKeys[K comparable, V any](a, b IDictionary[K, V]) bool {
+	keysA := a.Keys()
+	keysB := b.Keys()
+	if len(keysA) != len(keysB) {
+		return false
+	}
+
+	set := make(map[K]struct{}, len(keysB))
+	for _, key := range keysB {
+		set[key] = struct{}{}
+	}
+
+	for _, key := range keysA {
+		if _, ok := set[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// DictionaryMergeVectors combines two dictionaries of Vectors into a new Dictionary.
+// For keys present in both, the resulting Vector holds the elements of a followed by the elements of b.
+// Every Vector in the result is a new instance, so neither operand nor its Vectors are modified.
+//
+// Parameters:
+//   - a: The first Dictionary of Vectors.
+//   - b: The second Dictionary of Vectors, whose elements are appended after those of a.
+//
+// Returns:
+//   - A new Dictionary[K, *Vector[V]] containing the keys of both dictionaries.
+//
+// Example usage:
+//
+//	a := DictionaryFromMap(map[string]*Vector[int]{"x": VectorFromList([]int{1})})
+//	b := DictionaryFromMap(map[string]*Vector[int]{"x": VectorFromList([]int{2}), "y": VectorFromList([]int{3})})
+//	merged := DictionaryMergeVectors(a, b)
+//	// merged will contain {"x": [1, 2], "y": [3]}
+func DictionaryMergeVectors[K comparable, V any](a, b *Dictionary[K, *Vector[V]]) *Dictionary[K, *Vector[V]] {
+	merged := make(map[K]*Vector[V], len(a.items))
+	for key, vector := range a.items {
+		merged[key] = vector.Clone()
+	}
+
+	for key, vector := range b.items {
+		if found, ok := merged[key]; ok {
+			found.Append(vector.items...)
+			continue
+		}
+		merged[key] = vector.Clone()
+	}
+
+	return DictionaryFromMap(merged)
+}
+
+// DictionarySortedByValue returns the key-value pairs of the IDictionary as a Vector ordered by value.
+// Pairs with equal values are adjacent, but their relative order is not deterministic.
+//
+// Parameters:
+//   - c: The IDictionary whose pairs will be sorted.
+//   - less: A function that returns true if value a should be ordered before value b.
+//
+// Returns:
+//   - A new Vector[Pair[K, V]] containing every pair sorted by value.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"go": 90, "rust": 85, "zig": 92})
+//	ranking := DictionarySortedByValue(dict, func(a, b int) bool { return a > b })
+//	// ranking will contain [{zig 92}, {go 90}, {rust 85}]
+func DictionarySortedByValue[K comparable, V any](c IDictionary[K, V], less func(a, b V) bool) *Vector[Pair[K, V]] {
+	return VectorFromList(c.Pairs()).Sort(func(i, j Pair[K, V]) bool {
+		return less(i.value, j.value)
+	})
+}
+
+// DictionaryGetAs retrieves the value associated with the given key in an IDictionary of any values
+// and asserts it to the type V.
+//
+// Parameters:
+//   - c: The IDictionary holding values of type any.
+//   - key: The key of type K whose associated value is to be retrieved.
+//
+// Returns:
+//   - The value asserted to type V, or the zero value of V if the key is missing or holds another type.
+//   - A boolean indicating whether the key exists and its value is of type V.
+//
+// Example usage:
+//
+//	config := DictionaryFromMap(map[string]any{"port": 8080, "host": "localhost"})
+//	port, ok := DictionaryGetAs[int](config, "port") // port will be 8080, ok will be true
+//	host, ok := DictionaryGetAs[int](config, "host") // host will be 0, ok will be false
+func DictionaryGetAs[V any, K comparable](c IDictionary[K, any], key K) (V, bool) {
+	value, exists := c.Get(key)
+	if !exists {
+		var zero V
+		return zero, false
+	}
+	result, ok := value.(V)
+	return result, ok
+}
+
+// DictionaryRandom returns a uniformly random key-value pair of the IDictionary.
+// The keys are sorted before the position is drawn from the given source, so the selection does not
+// depend on map iteration order and a seeded source always yields the same sequence of pairs.
+//
+// Parameters:
+//   - c: The IDictionary to sample from.
+//   - r: The random source used to draw the position.
+//
+// Returns:
+//   - A randomly selected Pair[K, V], or a Pair holding zero values if the dictionary is empty.
+//   - A boolean indicating whether the dictionary was non-empty.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	pair, ok := DictionaryRandom(dict, rand.New(rand.NewPCG(1, 2)))
+//	// pair will be either {a 1} or {b 2}, always the same one for this seed, ok will be true
+func DictionaryRandom[K cmp.Ordered, V any](c IDictionary[K, V], r *rand.Rand) (Pair[K, V], bool) {
+	items := c.Collect()
+	if len(items) == 0 {
+		var zero Pair[K, V]
+		return zero, false
+	}
+
+	keys := slices.Sorted(maps.Keys(items))
+	key := keys[r.IntN(len(keys))]
+	return NewPair(key, items[key]), true
+}
+
+// DictionaryToVector creates a new Vector by applying the provided predicate function to each key-value pair of the IDictionary.
+// Due to the unordered nature of maps, the order of the resulting elements is not deterministic.
+//
+// Parameters:
+//   - c: The IDictionary whose pairs will be transformed.
+//   - predicate: A function that takes a key of type K and a value of type V, and returns an element of type R.
+//
+// Returns:
+//   - A new Vector[R] holding one element per key-value pair.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	lines := DictionaryToVector(dict, func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) })
+//	// lines will contain ["a=1", "b=2"] in no specific order
+func DictionaryToVector[K comparable, V, R any](c IDictionary[K, V], predicate func(K, V) R) *Vector[R] {
+	pairs := c.Pairs()
+	items := make([]R, len(pairs))
+	for i, pair := range pairs {
+		items[i] = predicate(pair.key, pair.value)
+	}
+	return VectorFromList(items)
+}
+
+// DictionaryMapEntries creates a new Dictionary by transforming both the key and the value of each key-value pair in the IDictionary.
+//
+// Key collisions: when the predicate produces the same new key for several entries, only one of them is kept.
+// Dictionaries are iterated in no specific order, so which of the colliding values survives is unspecified;
+// use a predicate that produces distinct keys, or group the values first, when every entry matters.
+//
+// Parameters:
+//   - c: The IDictionary whose key-value pairs will be transformed.
+//   - predicate: A function that takes a key of type K and a value of type V, and returns the new key of type E and the new value of type R.
+//
+// Returns:
+//   - A new IDictionary[E, R] holding the transformed key-value pairs.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	swapped := DictionaryMapEntries(dict, func(k string, v int) (int, string) { return v, strings.ToUpper(k) })
+//	// swapped will contain {1: "A", 2: "B"}
+func DictionaryMapEntries[K comparable, V any, E comparable, R any](c IDictionary[K, V], predicate func(K, V) (E, R)) IDictionary[E, R] {
+	source := c.Collect()
+	items := make(map[E]R, len(source))
+	for k, v := range source {
+		key, value := predicate(k, v)
+		items[key] = value
+	}
+	return MakeDictionary(items)
+}
+
+// VectorChunkDictionary splits the Vector into consecutive chunks of the given size and returns them in a Dictionary
+// keyed by chunk index, so that any batch can be accessed directly. The last chunk holds the remaining elements and
+// may be smaller than size. Each chunk is an independent copy, so the source Vector is not affected by changes to them.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type V.
+//   - size: The maximum number of elements per chunk.
+//
+// Returns:
+//   - A new Dictionary mapping each chunk index, starting at 0, to its Vector, or an empty Dictionary if size is lower than 1.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//	chunks := VectorChunkDictionary(vec, 2)
+//	// chunks will contain {0: [1, 2], 1: [3, 4], 2: [5]}
+func VectorChunkDictionary[V any](c *Vector[V], size int) *Dictionary[int, *Vector[V]] {
+	if size < 1 {
+		return DictionaryEmpty[int, *Vector[V]]()
+	}
+
+	chunks := make(map[int]*Vector[V], c.Pages(size))
+	for index, start := 0, 0; start < c.Size(); index, start = index+1, start+size {
+		chunks[index] = c.Slice(start, start+size)
+	}
+	return DictionaryFromMap(chunks)
+}
+
+// VectorGroupBy groups the elements of the Vector by the key produced for each of them. Unlike JoinBy, which merges
+// the elements sharing a key, every element is kept in the Vector of its key, in the order they appear in the source.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type V.
+//   - key: A function that derives the comparable key of type K of an element.
+//
+// Returns:
+//   - A new IDictionary mapping each key to a Vector holding all the elements that produced it.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//	groups := VectorGroupBy(vec, func(v int) bool { return v%2 == 0 })
+//	// groups will contain {false: [1, 3, 5], true: [2, 4]}
+func VectorGroupBy[V any, K comparable](c *Vector[V], key func(V) K) IDictionary[K, *Vector[V]] {
+	return ListGroupBy(c.items, key, MakeDictionary[K, *Vector[V]])
+}
+
+// DictionaryMinMaxByValue returns the key-value pairs holding the smallest and the largest values of the IDictionary,
+// found in a single traversal.
+// When several pairs hold an equal extreme value, which of them is returned is unspecified.
+//
+// Parameters:
+//   - c: The IDictionary to scan.
+//   - less: A function that returns true if the value a is ordered before the value b.
+//
+// Returns:
+//   - The Pair[K, V] holding the smallest value.
+//   - The Pair[K, V] holding the largest value.
+//   - A boolean indicating whether the IDictionary was non-empty.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 3, "b": 1, "c": 7})
+//	minPair, maxPair, ok := DictionaryMinMaxByValue(dict, func(a, b int) bool { return a < b })
+//	// minPair.Key() will be "b", maxPair.Key() will be "c", ok will be true
+func DictionaryMinMaxByValue[K comparable, V any](c IDictionary[K, V], less func(a, b V) bool) (Pair[K, V], Pair[K, V], bool) {
+	pairs := c.Pairs()
+	if len(pairs) == 0 {
+		var zero Pair[K, V]
+		return zero, zero, false
+	}
+
+	minPair, maxPair := pairs[0], pairs[0]
+	for _, pair := range pairs[1:] {
+		if less(pair.value, minPair.value) {
+			minPair = pair
+		}
+		if less(maxPair.value, pair.value) {
+			maxPair = pair
+		}
+	}
+	return minPair, maxPair, true
+}
+
+// DictionaryProject filters and transforms the key-value pairs of the IDictionary in a single pass, returning a new
+// Dictionary with the kept keys and their transformed values.
+//
+// Parameters:
+//   - c: The source IDictionary.
+//   - keep: A function that returns true for the key-value pairs to include in the projection.
+//   - transform: A function that produces the new value of type R of a kept key-value pair.
+//
+// Returns:
+//   - A new Dictionary[K, R] holding the transformed values of the kept keys.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//	projected := DictionaryProject(dict,
+//		func(k string, v int) bool { return v > 1 },
+//		func(k string, v int) string { return strings.Repeat(k, v) },
+//	) // projected will contain {"b": "bb", "c": "ccc"}
+func DictionaryProject[K comparable, V, R any](c IDictionary[K, V], keep func(K, V) bool, transform func(K, V) R) *Dictionary[K, R] {
+	items := make(map[K]R)
+	for k, v := range c.Collect() {
+		if keep(k, v) {
+			items[k] = transform(k, v)
+		}
+	}
+	return DictionaryFromMap(items)
+}
+
+// DictionaryForEachSorted iterates over the key-value pairs of the IDictionary in ascending key order, applying the
+// provided predicate function to each pair.
+//
+// Parameters:
+//   - c: The IDictionary to iterate, whose keys must be ordered.
+//   - predicate: A function that takes a key of type K and a value of type V, and performs an action or operation.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"b": 2, "a": 1, "c": 3})
+//	DictionaryForEachSorted(dict, func(k string, v int) { fmt.Println(k, v) })
+//	// Output:
+//	// a 1
+//	// b 2
+//	// c 3
+func DictionaryForEachSorted[K cmp.Ordered, V any](c IDictionary[K, V], predicate func(K, V)) {
+	items := c.Collect()
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		predicate(key, items[key])
+	}
+}
+
+// DictionaryCountByBucket counts how many keys of the IDictionary hold a value falling into each bucket,
+// where the bucket of a value is derived by the given function.
+//
+// Parameters:
+//   - c: The IDictionary whose values will be counted.
+//   - bucket: A function that derives the comparable bucket of type B of a value.
+//
+// Returns:
+//   - A new Dictionary[B, int] mapping each bucket to the number of keys whose value falls into it.
+//
+// Example usage:
+//
+//	ages := DictionaryFromMap(map[string]int{"ann": 17, "bob": 34, "eve": 29})
+//	counts := DictionaryCountByBucket(ages, func(age int) int { return age / 10 * 10 })
+//	// counts will contain {10: 1, 20: 1, 30: 1}
+func DictionaryCountByBucket[K comparable, V any, B comparable](c IDictionary[K, V], bucket func(V) B) *Dictionary[B, int] {
+	counts := make(map[B]int)
+	for _, v := range c.Collect() {
+		counts[bucket(v)]++
+	}
+	return DictionaryFromMap(counts)
+}
+
+// DictionaryDiff compares two IDictionaries key by key.
+//
+// Parameters:
+//   - older: The original IDictionary.
+//   - newer: The updated IDictionary.
+//
+// Returns:
+//   - A map with the entries whose key is only present in newer.
+//   - A map with the entries whose key is only present in older.
+//   - A map with the keys present in both whose value changed, holding the older value as the
+//     Pair key and the newer value as the Pair value.
+//
+// Example usage:
+//
+//	older := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//	newer := DictionaryFromMap(map[string]int{"b": 2, "c": 4, "d": 5})
+//	added, removed, changed := DictionaryDiff(older, newer)
+//	// added will be {"d": 5}, removed will be {"a": 1}, changed will be {"c": (3, 4)}
+func DictionaryDiff[K comparable, V comparable](older, newer IDictionary[K, V]) (map[K]V, map[K]V, map[K]Pair[V, V]) {
+	olderItems := older.Collect()
+	newerItems := newer.Collect()
+
+	added := make(map[K]V)
+	changed := make(map[K]Pair[V, V])
+	for k, v := range newerItems {
+		previous, ok := olderItems[k]
+		if !ok {
+			added[k] = v
+			continue
+		}
+		if previous != v {
+			changed[k] = NewPair(previous, v)
+		}
+	}
+
+	removed := make(map[K]V)
+	for k, v := range olderItems {
+		if _, ok := newerItems[k]; !ok {
+			removed[k] = v
+		}
+	}
+
+	return added, removed, changed
+}
+
+// DictionaryInvert creates a new Dictionary keyed by the values of the IDictionary, mapping each value back
+// to its key.
+//
+// Value collisions: when several keys share the same value only one of them is kept, as with DictionaryMapEntries.
+// Dictionaries are iterated in no specific order, so the last key written, and therefore the surviving one,
+// is unspecified; use DictionaryInvertMulti when every key matters.
+//
+// Parameters:
+//   - c: The IDictionary to invert.
+//
+// Returns:
+//   - A new IDictionary[V, K] mapping each distinct value to one of its keys.
+//
+// Example usage:
+//
+//	emails := DictionaryFromMap(map[int]string{1: "ann@mail.com", 2: "bob@mail.com"})
+//	users := DictionaryInvert(emails)
+//	// users will contain {"ann@mail.com": 1, "bob@mail.com": 2}
+func DictionaryInvert[K comparable, V comparable](c IDictionary[K, V]) IDictionary[V, K] {
+	return DictionaryMapEntries(c, func(k K, v V) (V, K) {
+		return v, k
+	})
+}
+
+// DictionaryInvertMulti creates a new Dictionary keyed by the values of the IDictionary, mapping each value
+// to a Vector with every key holding it. The order of the keys within each Vector is unspecified.
+//
+// Parameters:
+//   - c: The IDictionary to invert.
+//
+// Returns:
+//   - A new IDictionary[V, *Vector[K]] mapping each distinct value to all of its keys.
+//
+// Example usage:
+//
+//	roles := DictionaryFromMap(map[string]string{"ann": "admin", "bob": "user", "eve": "admin"})
+//	members := DictionaryInvertMulti(roles)
+//	// members will contain {"admin": ["ann", "eve"], "user": ["bob"]}, in any key order
+func DictionaryInvertMulti[K comparable, V comparable](c IDictionary[K, V]) IDictionary[V, *Vector[K]] {
+	groups := make(map[V]*Vector[K])
+	for k, v := range c.Collect() {
+		group, exists := groups[v]
+		if !exists {
+			group = VectorEmpty[K]()
+			groups[v] = group
+		}
+		group.Append(k)
+	}
+	return MakeDictionary(groups)
+}
+
+// VectorCountByKey counts the elements of the Vector by a derived key, mapping each distinct key to the
+// number of elements producing it.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type V.
+//   - key: A function that derives the comparable key of type K of an element.
+//
+// Returns:
+//   - A new Dictionary[K, int] whose counts add up to the size of the Vector.
+//
+// Example usage:
+//
+//	lines := VectorFromList([]string{"INFO start", "WARN slow", "INFO done"})
+//	counts := VectorCountByKey(lines, func(l string) string { return strings.Fields(l)[0] })
+//	// counts will contain {"INFO": 2, "WARN": 1}
+func VectorCountByKey[V any, K comparable](c *Vector[V], key func(V) K) *Dictionary[K, int] {
+	counts := make(map[K]int)
+	for _, item := range c.items {
+		counts[key(item)]++
+	}
+	return DictionaryFromMap(counts)
+}
+
+// DictionaryDistinctValues returns the distinct values held by the IDictionary. Dictionaries are iterated in no
+// specific order, so the order of the result is nondeterministic.
+//
+// Parameters:
+//   - c: The IDictionary whose values will be collected.
+//
+// Returns:
+//   - A new Vector holding every distinct value once, in no specific order.
+//
+// Example usage:
+//
+//	roles := DictionaryFromMap(map[string]string{"ann": "admin", "bob": "user", "eve": "admin"})
+//	distinct := DictionaryDistinctValues(roles)
+//	// distinct will contain ["admin", "user"] in no specific order
+func DictionaryDistinctValues[K comparable, V comparable](c IDictionary[K, V]) *Vector[V] {
+	return DictionaryDistinctValuesSet(c).ToVector()
+}
+
+// DictionaryDistinctValuesSet returns the distinct values held by the IDictionary as a Set.
+//
+// Parameters:
+//   - c: The IDictionary whose values will be collected.
+//
+// Returns:
+//   - A new Set holding every distinct value of the IDictionary.
+//
+// Example usage:
+//
+//	roles := DictionaryFromMap(map[string]string{"ann": "admin", "bob": "user", "eve": "admin"})
+//	distinct := DictionaryDistinctValuesSet(roles)
+//	// distinct will contain {"admin", "user"}
+func DictionaryDistinctValuesSet[K comparable, V comparable](c IDictionary[K, V]) *Set[V] {
+	return SetFromList(c.Values())
+}
+
+// DictionaryRebuild creates a new Dictionary by transforming both the key and the value of each key-value pair in the
+// IDictionary, resolving key collisions with the merge function instead of keeping an unspecified value as
+// DictionaryMapEntries does.
+//
+// Dictionaries are iterated in no specific order, so colliding values reach merge in an unspecified order;
+// use a commutative and associative merge for a deterministic result.
+//
+// Parameters:
+//   - c: The IDictionary whose key-value pairs will be transformed.
+//   - predicate: A function that takes a key of type K and a value of type V, and returns the new key of type E and the new value of type R.
+//   - merge: A function that combines the value already stored under a new key with an incoming one.
+//
+// Returns:
+//   - A new IDictionary[E, R] holding the transformed and merged key-value pairs.
+//
+// Example usage:
+//
+//	stock := DictionaryFromMap(map[string]int{"red apple": 3, "green apple": 2, "pear": 4})
+//	fruits := DictionaryRebuild(stock, func(k string, v int) (string, int) {
+//		fields := strings.Fields(k)
+//		return fields[len(fields)-1], v
+//	}, func(existing, incoming int) int { return existing + incoming })
+//	// fruits will contain {"apple": 5, "pear": 4}
+func DictionaryRebuild[K comparable, V any, E comparable, R any](c IDictionary[K, V], predicate func(K, V) (E, R), merge func(existing R, incoming R) R) IDictionary[E, R] {
+	source := c.Collect()
+	items := make(map[E]R, len(source))
+	for k, v := range source {
+		key, value := predicate(k, v)
+		if existing, exists := items[key]; exists {
+			value = merge(existing, value)
+		}
+		items[key] = value
+	}
+	return MakeDictionary(items)
+}
+
+// VectorBucketByKey assigns every element of the Vector to a bucket derived by the key function and folds the
+// elements of each bucket into an aggregate. Elements are folded in their Vector order, which makes it the
+// foundation of downsampling, e.g. keying by a truncated timestamp.
+//
+// The initial aggregate of every bucket is built by the seed function, so reference-typed aggregates such as
+// maps, pointers or slices are never shared between buckets.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type V.
+//   - bucketKey: A function that derives the comparable bucket of type K of an element.
+//   - seed: A function that builds the initial aggregate of a bucket the first time it is seen.
+//   - fold: A function that combines the current aggregate of a bucket with one of its elements.
+//
+// Returns:
+//   - A new Dictionary[K, A] mapping each bucket to the aggregate of its elements.
+//
+// Example usage:
+//
+//	readings := VectorFromList([]int{3, 7, 12, 18, 25})
+//	sums := VectorBucketByKey(readings, func(v int) int { return v / 10 * 10 },
+//		func(int) int { return 0 }, func(acc, v int) int { return acc + v })
+//	// sums will contain {0: 10, 10: 30, 20: 25}
+func VectorBucketByKey[V any, K comparable, A any](c *Vector[V], bucketKey func(V) K, seed func(K) A, fold func(A, V) A) *Dictionary[K, A] {
+	buckets := make(map[K]A)
+	for _, item := range c.items {
+		k := bucketKey(item)
+		aggregate, exists := buckets[k]
+		if !exists {
+			aggregate = seed(k)
+		}
+		buckets[k] = fold(aggregate, item)
+	}
+	return DictionaryFromMap(buckets)
+}
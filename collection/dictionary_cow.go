@@ -0,0 +1,844 @@
+package collection
+
+import (
+	"iter"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// DictionaryCOW is a thread-safe, copy-on-write generic key-value store where each key is of type K and each value is of type V.
+// It is meant for read-heavy workloads: reads never take a lock, while every write pays for a full copy of the map.
+// The zero value is an empty DictionaryCOW ready to use.
+//
+// Thread Safety:
+//   - The current map is published through an atomic.Pointer. Read operations (e.g., Get, Size) load it
+//     without locking, so readers never block each other nor wait for writers.
+//   - Write operations (e.g., Put, Remove) take a mutex, clone the current map, modify the clone and publish it.
+//     A published map is never modified again.
+//
+// Trade-offs:
+//   - Stale reads: a reader that loaded the map before a write completes keeps seeing the previous state.
+//     Each read is consistent with a single version of the map, but two consecutive reads may observe different versions.
+//   - Write amplification: every write copies the whole map, so a write costs O(n) time and memory.
+//     Prefer DictionarySync when writes are frequent or the map is large; use PutAll to batch writes.
+//
+// Fields:
+//   - mu: A mutex serializing the writers.
+//   - items: An atomic pointer to the current, immutable map of key-value pairs.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	dict.Put("c", 3)
+//	value, exists := dict.Get("a") // value will be 1, exists will be true
+type DictionaryCOW[K comparable, V any] struct {
+	mu    sync.Mutex
+	items atomic.Pointer[map[K]V]
+}
+
+// MakeDictionaryCOW creates a new DictionaryCOW from a given map.
+// It takes a map with keys of type K and values of type V and
+// returns a pointer to a IDictionary containing the same items.
+//
+// Example usage:
+//
+//	myMap := map[string]int{"a": 1, "b": 2}
+//	dict := MakeDictionaryCOW(myMap)
+func MakeDictionaryCOW[K comparable, V any](items map[K]V) IDictionary[K, V] {
+	return DictionaryCOWFromMap(items)
+}
+
+// DictionaryCOWFromMap creates a new DictionaryCOW from a given map.
+// The map is copied, so later changes to it do not affect the DictionaryCOW.
+//
+// Example usage:
+//
+//	myMap := map[string]int{"a": 1, "b": 2}
+//	dict := DictionaryCOWFromMap(myMap)
+func DictionaryCOWFromMap[K comparable, V any](items map[K]V) *DictionaryCOW[K, V] {
+	dict := &DictionaryCOW[K, V]{}
+	dict.store(maps.Clone(items))
+	return dict
+}
+
+// DictionaryCOWEmpty creates and returns a new, empty DictionaryCOW.
+//
+// Example usage:
+//
+//	emptyDict := DictionaryCOWEmpty[string, int]()
+func DictionaryCOWEmpty[K comparable, V any]() *DictionaryCOW[K, V] {
+	dict := &DictionaryCOW[K, V]{}
+	dict.store(make(map[K]V))
+	return dict
+}
+
+// load returns the current map. It must be treated as read-only.
+// A zero-value DictionaryCOW has published no map yet, so an empty one is returned instead.
+func (c *DictionaryCOW[K, V]) load() map[K]V {
+	items := c.items.Load()
+	if items == nil {
+		return make(map[K]V)
+	}
+	return *items
+}
+
+// store publishes the given map as the current one. The map must not be modified afterwards.
+func (c *DictionaryCOW[K, V]) store(items map[K]V) {
+	if items == nil {
+		items = make(map[K]V)
+	}
+	c.items.Store(&items)
+}
+
+// Size returns the number of key-value pairs in the DictionaryCOW.
+//
+// Returns:
+//   - An integer representing the number of elements in the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	size := dict.Size() // size will be 2
+func (c *DictionaryCOW[K, V]) Size() int {
+	return len(c.load())
+}
+
+// Exists checks if the given key exists in the DictionaryCOW.
+//
+// Parameters:
+//   - key: The key of type K to check for in the DictionaryCOW.
+//
+// Returns:
+//   - A boolean indicating whether the key exists in the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	exists := dict.Exists("a") // exists will be true
+func (c *DictionaryCOW[K, V]) Exists(key K) bool {
+	_, exists := c.load()[key]
+	return exists
+}
+
+// Find returns a slice of values from the DictionaryCOW that satisfy the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - A slice of values of type V that satisfy the predicate function.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//	result := dict.Find(func(k string, v int) bool { return v > 1 })
+//	// result will be [2, 3]
+func (c *DictionaryCOW[K, V]) Find(predicate func(K, V) bool) []V {
+	filter := []V{}
+	for k, v := range c.load() {
+		if predicate(k, v) {
+			filter = append(filter, v)
+		}
+	}
+	return filter
+}
+
+// FindOne searches for the first key-value pair in the DictionaryCOW that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - A copy of the value of type V if a matching key-value pair is found, or the zero value if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	value, found := dict.FindOne(func(k string, v int) bool { return v == 2 })
+//	// value will be 2, found will be true
+func (c *DictionaryCOW[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
+	for k, v := range c.load() {
+		if predicate(k, v) {
+			return v, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// FindPair searches for the first key-value pair in the DictionaryCOW that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The matching Pair[K, V], or a Pair holding zero values if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	pair, found := dict.FindPair(func(k string, v int) bool { return v == 2 })
+//	// pair.Key() will be "b", found will be true
+func (c *DictionaryCOW[K, V]) FindPair(predicate func(K, V) bool) (Pair[K, V], bool) {
+	for k, v := range c.load() {
+		if predicate(k, v) {
+			return NewPair(k, v), true
+		}
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
+// Get retrieves the value associated with the given key in the DictionaryCOW without taking any lock.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//
+// Returns:
+//   - A copy of the value of type V associated with the key, or the zero value if the key does not exist.
+//   - A boolean indicating whether the key was found.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	value, found := dict.Get("a") // value will be 1, found will be true
+func (c *DictionaryCOW[K, V]) Get(key K) (V, bool) {
+	value, exists := c.load()[key]
+	return value, exists
+}
+
+// GetOrDefault retrieves the value associated with the given key in the DictionaryCOW, or the fallback if the key does not exist.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key does not exist.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	value := dict.GetOrDefault("b", 0) // value will be 0
+func (c *DictionaryCOW[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, exists := c.load()[key]; exists {
+		return value
+	}
+	return fallback
+}
+
+// Put adds a key-value pair to the DictionaryCOW, updating the value if the key already exists.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key did not exist.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	old, exists := dict.Put("a", 3) // old will be 1, exists will be true
+func (c *DictionaryCOW[K, V]) Put(key K, item V) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	items := maps.Clone(c.load())
+	old, exists := items[key]
+	items[key] = item
+	c.store(items)
+	return old, exists
+}
+
+// PutIfAbsent adds a key-value pair to the DictionaryCOW only if the key does not already exist.
+// No copy is made when the key is already present.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key if the key is absent.
+//
+// Returns:
+//   - The existing value associated with the key, or the zero value if the key was absent.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	old, exists := dict.PutIfAbsent("b", 2) // old will be 0, exists will be false
+func (c *DictionaryCOW[K, V]) PutIfAbsent(key K, item V) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	if old, exists := current[key]; exists {
+		return old, exists
+	}
+
+	items := maps.Clone(current)
+	items[key] = item
+	c.store(items)
+
+	var zero V
+	return zero, false
+}
+
+// GetOrPut retrieves the value associated with the given key in the DictionaryCOW. If the key does not exist,
+// the fallback is stored under the key and returned. Hits are served without taking any lock.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V stored and returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key was absent.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	value := dict.GetOrPut("b", 2) // value will be 2, dict will contain {"a": 1, "b": 2}
+func (c *DictionaryCOW[K, V]) GetOrPut(key K, fallback V) V {
+	value, _ := c.ComputeIfAbsent(key, func(K) V {
+		return fallback
+	})
+	return value
+}
+
+// ComputeIfAbsent retrieves the value associated with the given key in the DictionaryCOW. If the key does not exist,
+// the factory is invoked with the key and its result is stored and returned. Hits are served without taking any lock.
+//
+// The factory runs while holding the writer mutex, so goroutines racing on the same missing key never build
+// the value twice. Readers are not blocked, but the factory must not write to the DictionaryCOW.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - factory: A function that builds the value of type V for a missing key.
+//
+// Returns:
+//   - The value associated with the key, or the value built by the factory if the key was absent.
+//   - A boolean indicating whether the factory ran, which tells a miss from a hit.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWEmpty[string, int]()
+//	value, computed := dict.ComputeIfAbsent("abc", func(k string) int { return len(k) }) // value will be 3, computed will be true
+func (c *DictionaryCOW[K, V]) ComputeIfAbsent(key K, factory func(K) V) (V, bool) {
+	if value, exists := c.load()[key]; exists {
+		return value, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	if value, exists := current[key]; exists {
+		return value, false
+	}
+
+	value := factory(key)
+	items := maps.Clone(current)
+	items[key] = value
+	c.store(items)
+	return value, true
+}
+
+// Compute updates the value associated with the given key in the DictionaryCOW in a single read-modify-write step.
+// The remap function receives the current value and whether the key exists, and returns the new value and whether
+// the key must be kept. Returning false removes the key, or leaves it absent if it did not exist.
+//
+// The remap function runs while holding the writer mutex, so concurrent calls on the same key never lose an update.
+// Readers are not blocked, but the remap function must not write to the DictionaryCOW.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be computed.
+//   - remap: A function that takes the key, the current value (the zero value if absent) and whether the key exists,
+//     and returns the new value of type V and whether to keep it.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value of type V if the key was removed.
+//   - A boolean indicating whether the key exists after the call.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWEmpty[string, int]()
+//	dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return old + 1, true }) // dict will contain {"hits": 1}
+func (c *DictionaryCOW[K, V]) Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	old, exists := current[key]
+	value, keep := remap(key, old, exists)
+	if !keep {
+		var zero V
+		if exists {
+			items := maps.Clone(current)
+			delete(items, key)
+			c.store(items)
+		}
+		return zero, false
+	}
+
+	items := maps.Clone(current)
+	items[key] = value
+	c.store(items)
+	return value, true
+}
+
+// PutAll adds all key-value pairs from another map to the DictionaryCOW, overwriting the existing keys.
+// The whole batch is applied with a single copy, and readers see either none or all of the new pairs.
+//
+// Parameters:
+//   - items: A map of type map[K]V containing the key-value pairs to add.
+//
+// Returns:
+//   - The DictionaryCOW itself, with all the new key-value pairs added.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	dict.PutAll(map[string]int{"b": 2, "c": 3}) // dict will contain {"a": 1, "b": 2, "c": 3}
+func (c *DictionaryCOW[K, V]) PutAll(items map[K]V) IDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	next := maps.Clone(c.load())
+	maps.Copy(next, items)
+	c.store(next)
+	return c
+}
+
+// Merge combines all key-value pairs from another IDictionary into the DictionaryCOW, overwriting the existing keys.
+//
+// Parameters:
+//   - other: The IDictionary to merge into the DictionaryCOW.
+//
+// Returns:
+//   - The DictionaryCOW itself, with the key-value pairs from the other IDictionary added.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	dict.Merge(DictionaryFromMap(map[string]int{"a": 2, "b": 3})) // dict will contain {"a": 2, "b": 3}
+func (c *DictionaryCOW[K, V]) Merge(other IDictionary[K, V]) IDictionary[K, V] {
+	return c.PutAll(other.Collect())
+}
+
+// Filter creates a new DictionaryCOW holding the key-value pairs that satisfy the provided predicate function.
+//
+// Parameters:
+//   - predicate: A function that returns true for the key-value pairs that should be kept in the result.
+//
+// Returns:
+//   - A new DictionaryCOW with the key-value pairs that satisfy the predicate.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	filtered := dict.Filter(func(k string, v int) bool { return v > 1 }) // filtered will contain {"b": 2}
+func (c *DictionaryCOW[K, V]) Filter(predicate func(K, V) bool) IDictionary[K, V] {
+	dict := &DictionaryCOW[K, V]{}
+	dict.store(cowFilter(c.load(), predicate))
+	return dict
+}
+
+// FilterSelf removes from the DictionaryCOW the key-value pairs that do not satisfy the provided predicate function.
+//
+// Parameters:
+//   - predicate: A function that returns true for the key-value pairs that should be retained.
+//
+// Returns:
+//   - The DictionaryCOW itself, with only the key-value pairs that satisfy the predicate.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	dict.FilterSelf(func(k string, v int) bool { return v > 1 }) // dict will contain {"b": 2}
+func (c *DictionaryCOW[K, V]) FilterSelf(predicate func(K, V) bool) IDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(cowFilter(c.load(), predicate))
+	return c
+}
+
+func cowFilter[K comparable, V any](items map[K]V, predicate func(K, V) bool) map[K]V {
+	filter := map[K]V{}
+	for key, v := range items {
+		if predicate(key, v) {
+			filter[key] = v
+		}
+	}
+	return filter
+}
+
+// Remove deletes a key-value pair from the DictionaryCOW. No copy is made when the key does not exist.
+//
+// Parameters:
+//   - key: The key of type K to remove.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key was not found.
+//   - A boolean indicating whether the key was present and removed.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	old, exists := dict.Remove("a") // old will be 1, exists will be true
+func (c *DictionaryCOW[K, V]) Remove(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	old, exists := current[key]
+	if !exists {
+		return old, exists
+	}
+
+	items := maps.Clone(current)
+	delete(items, key)
+	c.store(items)
+	return old, exists
+}
+
+// PopItem removes an arbitrary key-value pair from the DictionaryCOW and returns it.
+// The lookup and the removal happen under the writer mutex, so concurrent callers never pop the same pair.
+//
+// Returns:
+//   - The removed Pair[K, V], or a Pair holding zero values if the DictionaryCOW is empty.
+//   - A boolean indicating whether a pair was removed.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	pair, ok := dict.PopItem() // pair.Key() will be "a", ok will be true
+func (c *DictionaryCOW[K, V]) PopItem() (Pair[K, V], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	for key, value := range current {
+		items := maps.Clone(current)
+		delete(items, key)
+		c.store(items)
+		return NewPair(key, value), true
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
+// ForEach iterates over all key-value pairs of the DictionaryCOW as they were when the iteration started.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and performs an action or operation.
+//     The predicate may write to the DictionaryCOW; those writes are not visible to the running iteration.
+//
+// Returns:
+//   - The DictionaryCOW itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	dict.ForEach(func(k string, v int) { fmt.Println(k, v) })
+func (c *DictionaryCOW[K, V]) ForEach(predicate func(K, V)) IDictionary[K, V] {
+	for k, v := range c.load() {
+		predicate(k, v)
+	}
+	return c
+}
+
+// All returns an iterator over the key-value pairs of the DictionaryCOW as they were when the iteration started,
+// to be used with range-over-func and the iter and maps packages. No lock is held, so the loop body may write
+// to the DictionaryCOW; those writes are not visible to the running iteration.
+//
+// Returns:
+//   - An iter.Seq2 yielding each key together with its value, in no specific order.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	for k, v := range dict.All() {
+//		fmt.Println(k, v)
+//	}
+func (c *DictionaryCOW[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range c.load() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Map transforms the values in the DictionaryCOW by applying the provided predicate function to each key-value pair.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a new value of type V.
+//
+// Returns:
+//   - The DictionaryCOW itself, with the transformed values.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	dict.Map(func(k string, v int) int { return v * 2 }) // dict will contain {"a": 2, "b": 4}
+func (c *DictionaryCOW[K, V]) Map(predicate func(K, V) V) IDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.load()
+	items := make(map[K]V, len(current))
+	for k, v := range current {
+		items[k] = predicate(k, v)
+	}
+	c.store(items)
+	return c
+}
+
+// Clean removes all key-value pairs from the DictionaryCOW.
+//
+// Returns:
+//   - The DictionaryCOW itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	dict.Clean() // dict will be empty: {}
+func (c *DictionaryCOW[K, V]) Clean() IDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(make(map[K]V))
+	return c
+}
+
+// CleanWith removes all key-value pairs from the DictionaryCOW and then calls the provided predicate
+// function with every removed entry. The predicate runs after the writer lock is released.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and releases or processes the entry.
+//
+// Returns:
+//   - The DictionaryCOW itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]*os.File{"log": file})
+//	dict.CleanWith(func(k string, f *os.File) { f.Close() }) // dict will be empty: {}
+func (c *DictionaryCOW[K, V]) CleanWith(predicate func(K, V)) IDictionary[K, V] {
+	c.mu.Lock()
+	items := c.load()
+	c.store(make(map[K]V))
+	c.mu.Unlock()
+
+	for k, v := range items {
+		predicate(k, v)
+	}
+	return c
+}
+
+// Clone creates a shallow copy of the DictionaryCOW. Modifications to one do not affect the other.
+//
+// Returns:
+//   - A new DictionaryCOW with the same key-value pairs.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	cloned := dict.Clone()
+func (c *DictionaryCOW[K, V]) Clone() IDictionary[K, V] {
+	dict := &DictionaryCOW[K, V]{}
+	dict.store(c.load())
+	return dict
+}
+
+// Max returns the key-value pair from the DictionaryCOW that yields the maximum
+// score when evaluated with the provided predicate function.
+//
+// Due to the unordered nature of maps, if multiple pairs produce the same
+// maximum score, the returned pair is not deterministic.
+//
+// Parameters:
+//   - predicate: A function that takes a key and a value, and returns an
+//     integer score used for comparison.
+//
+// Returns:
+//   - A Pair containing the key and value with the maximum score.
+//   - The maximum score returned by the predicate.
+//   - A boolean indicating whether the DictionaryCOW was non-empty.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"go": 14, "rust": 11})
+//	pair, score, ok := dict.Max(func(k string, v int) int { return v })
+//	// pair.Key() == "go", score == 14, ok == true
+func (c *DictionaryCOW[K, V]) Max(predicate func(k K, v V) int) (Pair[K, V], int, bool) {
+	var (
+		maxPair  Pair[K, V]
+		maxScore int
+		init     bool
+	)
+
+	for k, v := range c.load() {
+		score := predicate(k, v)
+
+		if !init || score >= maxScore {
+			maxPair = NewPair(k, v)
+			maxScore = score
+			init = true
+		}
+	}
+
+	return maxPair, maxScore, init
+}
+
+// Min returns the key-value pair from the DictionaryCOW that yields the minimum
+// score when evaluated with the provided predicate function.
+//
+// Due to the unordered nature of maps, if multiple pairs produce the same
+// minimum score, the returned pair is not deterministic.
+//
+// Parameters:
+//   - predicate: A function that takes a key and a value, and returns an
+//     integer score used for comparison.
+//
+// Returns:
+//   - A Pair containing the key and value that produced the minimum score.
+//   - The minimum score returned by the predicate.
+//   - A boolean indicating whether the DictionaryCOW was non-empty.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"go": 14, "rust": 11})
+//	pair, score, ok := dict.Min(func(k string, v int) int { return v })
+//	// pair.Key() == "rust", score == 11, ok == true
+func (c *DictionaryCOW[K, V]) Min(predicate func(k K, v V) int) (Pair[K, V], int, bool) {
+	var (
+		minPair  Pair[K, V]
+		minScore int
+		init     bool
+	)
+
+	for k, v := range c.load() {
+		score := predicate(k, v)
+
+		if !init || score <= minScore {
+			minPair = NewPair(k, v)
+			minScore = score
+			init = true
+		}
+	}
+
+	return minPair, minScore, init
+}
+
+// Keys returns a slice of all the keys in the DictionaryCOW. The keys are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []K containing all the keys in the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	keys := dict.Keys() // keys will contain []string{"a", "b"}
+func (c *DictionaryCOW[K, V]) Keys() []K {
+	items := c.load()
+	keys := make([]K, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// KeysVector returns a Vector containing all the keys in the DictionaryCOW.
+//
+// Returns:
+//   - A Vector[K] containing all the keys from the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	keysVector := dict.KeysVector() // keysVector will be a Vector containing ["a", "b"]
+func (c *DictionaryCOW[K, V]) KeysVector() *Vector[K] {
+	return VectorFromList(c.Keys())
+}
+
+// Values returns a slice containing all the values in the DictionaryCOW. The values are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []V containing all the values in the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	values := dict.Values() // values will contain []int{1, 2}
+func (c *DictionaryCOW[K, V]) Values() []V {
+	items := c.load()
+	values := make([]V, 0, len(items))
+	for _, value := range items {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ValuesVector returns a Vector containing all the values in the DictionaryCOW.
+//
+// Returns:
+//   - A Vector[V] containing all the values from the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	valuesVector := dict.ValuesVector() // valuesVector will be a Vector containing [1, 2]
+func (c *DictionaryCOW[K, V]) ValuesVector() *Vector[V] {
+	return VectorFromList(c.Values())
+}
+
+// Pairs returns a slice of key-value pairs in the DictionaryCOW. The pairs are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []Pair[K, V] containing all key-value pairs from the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	pairs := dict.Pairs() // pairs will contain [{a 1}, {b 2}]
+func (c *DictionaryCOW[K, V]) Pairs() []Pair[K, V] {
+	items := c.load()
+	pairs := make([]Pair[K, V], 0, len(items))
+	for k, v := range items {
+		pairs = append(pairs, NewPair(k, v))
+	}
+	return pairs
+}
+
+// Collect returns a copy of the map holding all the key-value pairs in the DictionaryCOW.
+//
+// Returns:
+//   - A map of type map[K]V containing all key-value pairs in the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1, "b": 2})
+//	collectedMap := dict.Collect() // collectedMap will be map[string]int{"a": 1, "b": 2}
+func (c *DictionaryCOW[K, V]) Collect() map[K]V {
+	return maps.Clone(c.load())
+}
+
+// Freeze returns a read-only view of the DictionaryCOW. The view is backed by the same
+// DictionaryCOW, so later writes are visible through it.
+//
+// Returns:
+//   - An IReadDictionary[K, V] exposing only the read operations of the DictionaryCOW.
+//
+// Example usage:
+//
+//	dict := DictionaryCOWFromMap(map[string]int{"a": 1})
+//	frozen := dict.Freeze()
+func (c *DictionaryCOW[K, V]) Freeze() IReadDictionary[K, V] {
+	return ImmutableDictionaryFrom[K, V](c)
+}
@@ -0,0 +1,211 @@
+package collection
+
+// ImmutableDictionary is a read-only view over an IDictionary. It exposes only the
+// read operations defined by IReadDictionary, so the holder of the view cannot
+// modify the underlying key-value pairs.
+//
+// The view is backed by the same data as the source dictionary: it does not copy
+// the items, so changes made through the source are visible through the view.
+// Thread safety is inherited from the source, reads over a DictionarySync take its read lock.
+//
+// Fields:
+//   - items: The source IDictionary the view reads from.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	frozen := dict.Freeze()
+//	value, exists := frozen.Get("a") // value will be 1, exists will be true
+type ImmutableDictionary[K comparable, V any] struct {
+	items IDictionary[K, V]
+}
+
+// ImmutableDictionaryFrom creates a new read-only view over the given IDictionary.
+//
+// Parameters:
+//   - items: The IDictionary to be exposed as read-only.
+//
+// Returns:
+//   - A pointer to an ImmutableDictionary backed by the given IDictionary.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	frozen := ImmutableDictionaryFrom[string, int](dict)
+func ImmutableDictionaryFrom[K comparable, V any](items IDictionary[K, V]) *ImmutableDictionary[K, V] {
+	return &ImmutableDictionary[K, V]{
+		items: items,
+	}
+}
+
+// Size returns the number of key-value pairs in the ImmutableDictionary.
+//
+// Returns:
+//   - An integer representing the number of elements in the ImmutableDictionary.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	size := frozen.Size() // size will be 2
+func (c *ImmutableDictionary[K, V]) Size() int {
+	return c.items.Size()
+}
+
+// Exists checks if the given key exists in the ImmutableDictionary.
+//
+// Parameters:
+//   - key: The key of type K to check for in the ImmutableDictionary.
+//
+// Returns:
+//   - A boolean indicating whether the key exists in the ImmutableDictionary.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	exists := frozen.Exists("a") // exists will be true
+func (c *ImmutableDictionary[K, V]) Exists(key K) bool {
+	return c.items.Exists(key)
+}
+
+// Find returns a slice of values from the ImmutableDictionary that satisfy the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - A slice of values of type V that satisfy the predicate function.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3}).Freeze()
+//	result := frozen.Find(func(k string, v int) bool { return v > 1 })
+//	// result will be [2, 3]
+func (c *ImmutableDictionary[K, V]) Find(predicate func(K, V) bool) []V {
+	return c.items.Find(predicate)
+}
+
+// FindOne searches for the first key-value pair in the ImmutableDictionary that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The value of type V if a matching key-value pair is found, or the zero value if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	value, found := frozen.FindOne(func(k string, v int) bool { return v == 2 })
+//	// value will be 2, found will be true
+func (c *ImmutableDictionary[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
+	return c.items.FindOne(predicate)
+}
+
+// FindPair searches for the first key-value pair in the ImmutableDictionary that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The matching Pair[K, V], or a Pair holding zero values if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	pair, found := frozen.FindPair(func(k string, v int) bool { return v == 2 })
+//	// pair.Key() will be "b", found will be true
+func (c *ImmutableDictionary[K, V]) FindPair(predicate func(K, V) bool) (Pair[K, V], bool) {
+	return c.items.FindPair(predicate)
+}
+
+// Get retrieves the value associated with the given key in the ImmutableDictionary.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//
+// Returns:
+//   - The value of type V associated with the key, or the zero value if the key does not exist.
+//   - A boolean indicating whether the key was found.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	value, found := frozen.Get("a") // value will be 1, found will be true
+func (c *ImmutableDictionary[K, V]) Get(key K) (V, bool) {
+	return c.items.Get(key)
+}
+
+// GetOrDefault retrieves the value associated with the given key in the ImmutableDictionary, or the fallback if the key does not exist.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key does not exist.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1}).Freeze()
+//	value := frozen.GetOrDefault("b", 0) // value will be 0
+func (c *ImmutableDictionary[K, V]) GetOrDefault(key K, fallback V) V {
+	return c.items.GetOrDefault(key, fallback)
+}
+
+// ForEach iterates over all key-value pairs in the ImmutableDictionary, applying the provided predicate function to each pair.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and performs an action or operation.
+//
+// Returns:
+//   - The ImmutableDictionary itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	frozen.ForEach(func(k string, v int) { fmt.Println(k, v) })
+func (c *ImmutableDictionary[K, V]) ForEach(predicate func(K, V)) IReadDictionary[K, V] {
+	c.items.ForEach(predicate)
+	return c
+}
+
+// Keys returns a slice of all the keys in the ImmutableDictionary. The keys are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []K containing all the keys in the ImmutableDictionary.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	keys := frozen.Keys() // keys will contain []string{"a", "b"}
+func (c *ImmutableDictionary[K, V]) Keys() []K {
+	return c.items.Keys()
+}
+
+// Values returns a slice containing all the values in the ImmutableDictionary. The values are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []V containing all the values in the ImmutableDictionary.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	values := frozen.Values() // values will contain []int{1, 2}
+func (c *ImmutableDictionary[K, V]) Values() []V {
+	return c.items.Values()
+}
+
+// Pairs returns a slice of key-value pairs in the ImmutableDictionary. The pairs are returned in no specific order.
+//
+// Returns:
+//   - A slice of type []Pair[K, V] containing all key-value pairs from the ImmutableDictionary.
+//
+// Example usage:
+//
+//	frozen := DictionaryFromMap(map[string]int{"a": 1, "b": 2}).Freeze()
+//	pairs := frozen.Pairs() // pairs will contain [{a 1}, {b 2}]
+func (c *ImmutableDictionary[K, V]) Pairs() []Pair[K, V] {
+	return c.items.Pairs()
+}
@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the Dictionary as a JSON object of its entries.
+// Keys follow the encoding/json rules for map keys, so they must be strings, integers or implement
+// encoding.TextMarshaler.
+//
+// Returns:
+//   - The JSON encoding of the entries.
+//   - An error if any key or value could not be encoded, or nil otherwise.
+//
+// Example usage:
+//
+//	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+//	data, err := json.Marshal(dict)
+//	// data will be {"a":1,"b":2}
+func (c *Dictionary[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalDictionary(c.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON object into the Dictionary and replacing
+// its current entries. A JSON null leaves the Dictionary empty.
+//
+// Parameters:
+//   - data: The JSON object to decode.
+//
+// Returns:
+//   - An error if the input is not an object of keys K and values V, or nil otherwise.
+//
+// Example usage:
+//
+//	dict := DictionaryEmpty[string, int]()
+//	err := json.Unmarshal([]byte(`{"a":1,"b":2}`), dict)
+//	// dict will contain {"a": 1, "b": 2}
+func (c *Dictionary[K, V]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalDictionary[K, V](data)
+	if err != nil {
+		return err
+	}
+	c.items = items
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the DictionarySync as a JSON object of its entries
+// while holding the read lock.
+//
+// Returns:
+//   - The JSON encoding of the entries.
+//   - An error if any key or value could not be encoded, or nil otherwise.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
+//	data, err := json.Marshal(dict)
+//	// data will be {"a":1,"b":2}
+func (c *DictionarySync[K, V]) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return marshalDictionary(c.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON object into the DictionarySync and replacing
+// its current entries. The input is decoded before the write lock is taken, so the lock is only held to
+// swap the contents.
+//
+// Parameters:
+//   - data: The JSON object to decode.
+//
+// Returns:
+//   - An error if the input is not an object of keys K and values V, or nil otherwise.
+//
+// Example usage:
+//
+//	dict := DictionarySyncEmpty[string, int]()
+//	err := json.Unmarshal([]byte(`{"a":1,"b":2}`), dict)
+//	// dict will contain {"a": 1, "b": 2}
+func (c *DictionarySync[K, V]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalDictionary[K, V](data)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = items
+	return nil
+}
+
+func marshalDictionary[K comparable, V any](items map[K]V) ([]byte, error) {
+	if items == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(items)
+}
+
+func unmarshalDictionary[K comparable, V any](data []byte) (map[K]V, error) {
+	var items map[K]V
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = make(map[K]V)
+	}
+	return items, nil
+}
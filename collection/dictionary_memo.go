@@ -0,0 +1,122 @@
+package collection
+
+import "sync"
+
+// MemoDictionary is a thread-safe cache of computed values. Each value is produced by the
+// compute function the first time its key is requested and is served from the cache afterwards.
+//
+// Thread Safety:
+//   - Entries are stored in a DictionarySync, so the cache can be shared between goroutines.
+//   - Concurrent requests for the same missing key are collapsed: compute runs exactly once
+//     per key and every caller receives the same value.
+//
+// Fields:
+//   - items: A DictionarySync storing one entry per requested key.
+//   - compute: The function producing the value of a missing key.
+//
+// Example usage:
+//
+//	memo := Memoize(func(n int) int { return n * n })
+//	value := memo.Get(4) // value will be 16, computed on this first call
+//	value = memo.Get(4)  // value will be 16, served from the cache
+type MemoDictionary[K comparable, V any] struct {
+	items   *DictionarySync[K, *memoEntry[V]]
+	compute func(K) V
+}
+
+type memoEntry[V any] struct {
+	once  sync.Once
+	value V
+}
+
+// Memoize creates a new, empty MemoDictionary backed by the given compute function.
+//
+// Parameters:
+//   - compute: A function that produces the value of type V for a key of type K.
+//
+// Returns:
+//   - A pointer to a MemoDictionary caching the results of compute.
+//
+// Example usage:
+//
+//	memo := Memoize(func(path string) []byte { return load(path) })
+func Memoize[K comparable, V any](compute func(K) V) *MemoDictionary[K, V] {
+	return &MemoDictionary[K, V]{
+		items:   DictionarySyncEmpty[K, *memoEntry[V]](),
+		compute: compute,
+	}
+}
+
+// Get returns the cached value for the given key, computing and storing it first if the key is missing.
+// If several goroutines request the same missing key, only one of them runs compute while the others wait for its result.
+//
+// Parameters:
+//   - key: The key of type K whose value is to be retrieved.
+//
+// Returns:
+//   - The value of type V associated with the key.
+//
+// Example usage:
+//
+//	memo := Memoize(func(n int) int { return n * n })
+//	value := memo.Get(3) // value will be 9
+func (c *MemoDictionary[K, V]) Get(key K) V {
+	entry := &memoEntry[V]{}
+	if found, exists := c.items.PutIfAbsent(key, entry); exists {
+		entry = found
+	}
+
+	entry.once.Do(func() {
+		entry.value = c.compute(key)
+	})
+
+	return entry.value
+}
+
+// Exists checks if a value for the given key has been requested and cached in the MemoDictionary.
+//
+// Parameters:
+//   - key: The key of type K to check for.
+//
+// Returns:
+//   - A boolean indicating whether the key is cached.
+//
+// Example usage:
+//
+//	memo := Memoize(func(n int) int { return n * n })
+//	exists := memo.Exists(3) // exists will be false until memo.Get(3) is called
+func (c *MemoDictionary[K, V]) Exists(key K) bool {
+	return c.items.Exists(key)
+}
+
+// Size returns the number of cached keys in the MemoDictionary.
+//
+// Returns:
+//   - An integer representing the number of cached keys.
+//
+// Example usage:
+//
+//	memo := Memoize(func(n int) int { return n * n })
+//	memo.Get(2)
+//	size := memo.Size() // size will be 1
+func (c *MemoDictionary[K, V]) Size() int {
+	return c.items.Size()
+}
+
+// Remove evicts the cached value of the given key, so the next Get computes it again.
+//
+// Parameters:
+//   - key: The key of type K to evict.
+//
+// Returns:
+//   - A boolean indicating whether the key was cached.
+//
+// Example usage:
+//
+//	memo := Memoize(func(n int) int { return n * n })
+//	memo.Get(2)
+//	removed := memo.Remove(2) // removed will be true
+func (c *MemoDictionary[K, V]) Remove(key K) bool {
+	_, exists := c.items.Remove(key)
+	return exists
+}
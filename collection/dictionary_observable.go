@@ -0,0 +1,355 @@
+package collection
+
+import "sync"
+
+// ObservableDictionary wraps an IDictionary and notifies registered observers when entries are
+// put or removed. It is opt-in: the wrapped dictionary is not aware of the observers, and any
+// change made directly on it is not reported.
+//
+// Observed operations:
+//   - Put, PutIfAbsent, PutAll and Merge notify OnPut observers for every stored entry.
+//   - Remove notifies OnRemove observers when the key existed.
+//   - Bulk rewrites (FilterSelf, Map, Clean, CleanWith) are not reported.
+//
+// Thread Safety:
+//   - Observers are invoked after the mutation has completed. When the wrapped dictionary is a
+//     DictionarySync its lock has already been released, so an observer may call back into the dictionary.
+//   - Registering observers is safe to do concurrently with mutations.
+//
+// Fields:
+//   - IDictionary: The wrapped dictionary that stores the entries.
+//   - mu: A read-write mutex protecting the observer lists.
+//   - onPut: The observers notified after an entry is stored.
+//   - onRemove: The observers notified after an entry is removed.
+//
+// Example usage:
+//
+//	dict := ObservableDictionaryFrom(MakeDictionary(map[string]int{}))
+//	dict.OnPut(func(k string, old int, exists bool, v int) { fmt.Println("put", k, v) })
+//	dict.Put("a", 1) // prints "put a 1"
+type ObservableDictionary[K comparable, V any] struct {
+	IDictionary[K, V]
+	mu       sync.RWMutex
+	onPut    []func(key K, old V, exists bool, value V)
+	onRemove []func(key K, old V)
+}
+
+// ObservableDictionaryFrom creates a new ObservableDictionary wrapping the given IDictionary.
+//
+// Parameters:
+//   - items: The IDictionary whose mutations should be observed.
+//
+// Returns:
+//   - A pointer to an ObservableDictionary with no observers registered.
+//
+// Example usage:
+//
+//	dict := ObservableDictionaryFrom(MakeDictionarySync(map[string]int{}))
+func ObservableDictionaryFrom[K comparable, V any](items IDictionary[K, V]) *ObservableDictionary[K, V] {
+	return &ObservableDictionary[K, V]{
+		IDictionary: items,
+	}
+}
+
+// OnPut registers an observer invoked after an entry has been stored in the ObservableDictionary.
+//
+// Parameters:
+//   - observer: A function receiving the key, the previous value, whether the key already existed, and the new value.
+//
+// Returns:
+//   - The ObservableDictionary itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict.OnPut(func(k string, old int, exists bool, v int) { fmt.Println(k, old, exists, v) })
+func (c *ObservableDictionary[K, V]) OnPut(observer func(key K, old V, exists bool, value V)) *ObservableDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onPut = append(c.onPut, observer)
+	return c
+}
+
+// OnRemove registers an observer invoked after an entry has been removed from the ObservableDictionary.
+//
+// Parameters:
+//   - observer: A function receiving the removed key and its last value.
+//
+// Returns:
+//   - The ObservableDictionary itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict.OnRemove(func(k string, old int) { fmt.Println(k, old) })
+func (c *ObservableDictionary[K, V]) OnRemove(observer func(key K, old V)) *ObservableDictionary[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onRemove = append(c.onRemove, observer)
+	return c
+}
+
+// Put adds a key-value pair to the wrapped dictionary and notifies the OnPut observers.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key did not exist.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	old, exists := dict.Put("a", 3)
+func (c *ObservableDictionary[K, V]) Put(key K, item V) (V, bool) {
+	old, exists := c.IDictionary.Put(key, item)
+	c.notifyPut(key, old, exists, item)
+	return old, exists
+}
+
+// PutIfAbsent adds a key-value pair to the wrapped dictionary only if the key does not already exist,
+// notifying the OnPut observers when the entry is stored.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key if the key is absent.
+//
+// Returns:
+//   - The existing value associated with the key, or the zero value if the key was absent.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	old, exists := dict.PutIfAbsent("a", 3)
+func (c *ObservableDictionary[K, V]) PutIfAbsent(key K, item V) (V, bool) {
+	old, exists := c.IDictionary.PutIfAbsent(key, item)
+	if !exists {
+		c.notifyPut(key, old, exists, item)
+	}
+	return old, exists
+}
+
+// GetOrPut retrieves the value associated with the given key in the wrapped dictionary, storing the fallback
+// when the key does not exist and notifying the OnPut observers in that case.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V stored and returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key was absent.
+//
+// Example usage:
+//
+//	value := dict.GetOrPut("a", 3)
+func (c *ObservableDictionary[K, V]) GetOrPut(key K, fallback V) V {
+	old, exists := c.IDictionary.PutIfAbsent(key, fallback)
+	if exists {
+		return old
+	}
+	c.notifyPut(key, old, exists, fallback)
+	return fallback
+}
+
+// ComputeIfAbsent retrieves the value associated with the given key in the wrapped dictionary, storing the value
+// built by the factory when the key does not exist and notifying the OnPut observers in that case.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - factory: A function that builds the value of type V for a missing key.
+//
+// Returns:
+//   - The value associated with the key, or the value built by the factory if the key was absent.
+//   - A boolean indicating whether the factory ran, which tells a miss from a hit.
+//
+// Example usage:
+//
+//	value, computed := dict.ComputeIfAbsent("a", func(k string) int { return len(k) })
+func (c *ObservableDictionary[K, V]) ComputeIfAbsent(key K, factory func(K) V) (V, bool) {
+	value, computed := c.IDictionary.ComputeIfAbsent(key, factory)
+	if computed {
+		var zero V
+		c.notifyPut(key, zero, false, value)
+	}
+	return value, computed
+}
+
+// Compute updates the value associated with the given key in the wrapped dictionary in a single read-modify-write step,
+// notifying the OnPut observers when the key is kept and the OnRemove observers when an existing key is removed.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be computed.
+//   - remap: A function that takes the key, the current value (the zero value if absent) and whether the key exists,
+//     and returns the new value of type V and whether to keep it.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value of type V if the key was removed.
+//   - A boolean indicating whether the key exists after the call.
+//
+// Example usage:
+//
+//	dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return old + 1, true })
+func (c *ObservableDictionary[K, V]) Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool) {
+	var previous V
+	var existed bool
+	value, kept := c.IDictionary.Compute(key, func(k K, old V, exists bool) (V, bool) {
+		previous, existed = old, exists
+		return remap(k, old, exists)
+	})
+
+	if kept {
+		c.notifyPut(key, previous, existed, value)
+	} else if existed {
+		c.notifyRemove(key, previous)
+	}
+	return value, kept
+}
+
+// PutAll adds all key-value pairs from the given map, notifying the OnPut observers for each of them.
+//
+// Parameters:
+//   - items: A map of type map[K]V containing the key-value pairs to add.
+//
+// Returns:
+//   - The ObservableDictionary itself, with all the new key-value pairs added.
+//
+// Example usage:
+//
+//	dict.PutAll(map[string]int{"b": 3, "c": 4})
+func (c *ObservableDictionary[K, V]) PutAll(items map[K]V) IDictionary[K, V] {
+	for key, item := range items {
+		c.Put(key, item)
+	}
+	return c
+}
+
+// Merge combines all key-value pairs from another IDictionary, notifying the OnPut observers for each of them.
+//
+// Parameters:
+//   - other: The IDictionary to merge into the ObservableDictionary.
+//
+// Returns:
+//   - The ObservableDictionary itself, with the key-value pairs from the other IDictionary added.
+//
+// Example usage:
+//
+//	dict.Merge(DictionaryFromMap(map[string]int{"b": 3}))
+func (c *ObservableDictionary[K, V]) Merge(other IDictionary[K, V]) IDictionary[K, V] {
+	return c.PutAll(other.Collect())
+}
+
+// Remove deletes a key-value pair from the wrapped dictionary, notifying the OnRemove observers if the key existed.
+//
+// Parameters:
+//   - key: The key of type K to remove.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key was not found.
+//   - A boolean indicating whether the key was present and removed.
+//
+// Example usage:
+//
+//	old, exists := dict.Remove("a")
+func (c *ObservableDictionary[K, V]) Remove(key K) (V, bool) {
+	old, exists := c.IDictionary.Remove(key)
+	if exists {
+		c.notifyRemove(key, old)
+	}
+	return old, exists
+}
+
+// PopItem removes an arbitrary key-value pair from the wrapped dictionary, notifying the OnRemove observers if a pair was removed.
+//
+// Returns:
+//   - The removed Pair[K, V], or a Pair holding zero values if the dictionary is empty.
+//   - A boolean indicating whether a pair was removed.
+//
+// Example usage:
+//
+//	pair, ok := dict.PopItem()
+func (c *ObservableDictionary[K, V]) PopItem() (Pair[K, V], bool) {
+	pair, ok := c.IDictionary.PopItem()
+	if ok {
+		c.notifyRemove(pair.Key(), pair.Value())
+	}
+	return pair, ok
+}
+
+// ForEach iterates over all key-value pairs of the wrapped dictionary.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and performs an action or operation.
+//
+// Returns:
+//   - The ObservableDictionary itself, allowing for method chaining.
+func (c *ObservableDictionary[K, V]) ForEach(predicate func(K, V)) IDictionary[K, V] {
+	c.IDictionary.ForEach(predicate)
+	return c
+}
+
+// FilterSelf filters the key-value pairs of the wrapped dictionary. Removed entries are not reported.
+//
+// Parameters:
+//   - predicate: A function that returns true for the key-value pairs that should be retained.
+//
+// Returns:
+//   - The ObservableDictionary itself, with only the key-value pairs that satisfy the predicate.
+func (c *ObservableDictionary[K, V]) FilterSelf(predicate func(K, V) bool) IDictionary[K, V] {
+	c.IDictionary.FilterSelf(predicate)
+	return c
+}
+
+// Map transforms the values of the wrapped dictionary. Transformed entries are not reported.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a new value of type V.
+//
+// Returns:
+//   - The ObservableDictionary itself, with the transformed values.
+func (c *ObservableDictionary[K, V]) Map(predicate func(K, V) V) IDictionary[K, V] {
+	c.IDictionary.Map(predicate)
+	return c
+}
+
+// Clean removes all key-value pairs from the wrapped dictionary. Removed entries are not reported.
+//
+// Returns:
+//   - The ObservableDictionary itself, now empty, allowing for method chaining.
+func (c *ObservableDictionary[K, V]) Clean() IDictionary[K, V] {
+	c.IDictionary.Clean()
+	return c
+}
+
+// CleanWith removes all key-value pairs from the wrapped dictionary, calling the provided predicate
+// function with every entry. Removed entries are not reported.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and releases or processes the entry.
+//
+// Returns:
+//   - The ObservableDictionary itself, now empty, allowing for method chaining.
+func (c *ObservableDictionary[K, V]) CleanWith(predicate func(K, V)) IDictionary[K, V] {
+	c.IDictionary.CleanWith(predicate)
+	return c
+}
+
+func (c *ObservableDictionary[K, V]) notifyPut(key K, old V, exists bool, value V) {
+	c.mu.RLock()
+	observers := c.onPut
+	c.mu.RUnlock()
+
+	for _, observer := range observers {
+		observer(key, old, exists, value)
+	}
+}
+
+func (c *ObservableDictionary[K, V]) notifyRemove(key K, old V) {
+	c.mu.RLock()
+	observers := c.onRemove
+	c.mu.RUnlock()
+
+	for _, observer := range observers {
+		observer(key, old)
+	}
+}
@@ -0,0 +1,731 @@
+package collection
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// DictionaryOrdered is a generic key-value store that remembers the order in which keys were first inserted.
+// Keys, Values, Pairs, ForEach and every other iteration follow that order, so their output is deterministic.
+//
+// Ordering rules:
+//   - Putting a new key appends it to the end of the order.
+//   - Putting an existing key updates its value but keeps its original position.
+//   - Removing a key drops it from the order; putting it again appends it to the end.
+//
+// Trade-offs:
+//   - Reads and inserts cost the same as in a Dictionary.
+//   - Removing a key is O(n), as its position has to be found and closed in the order.
+//
+// Fields:
+//   - items: A map storing the actual key-value pairs.
+//   - order: A Vector holding every key of items once, in insertion order.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedEmpty[string, int]()
+//	dict.Put("b", 2)
+//	dict.Put("a", 1)
+//	keys := dict.Keys() // keys will be ["b", "a"]
+type DictionaryOrdered[K comparable, V any] struct {
+	items map[K]V
+	order *Vector[K]
+}
+
+// MakeDictionaryOrdered creates a new DictionaryOrdered from a given map, so it can be used as a
+// DictionaryConstructor. Go maps have no order, so the initial keys are ordered as the map is iterated;
+// use DictionaryOrderedFromPairs when the initial order matters.
+//
+// Example usage:
+//
+//	dict := MakeDictionaryOrdered(map[string]int{"a": 1})
+func MakeDictionaryOrdered[K comparable, V any](items map[K]V) IDictionary[K, V] {
+	return DictionaryOrderedFromMap(items)
+}
+
+// DictionaryOrderedFromMap creates a new DictionaryOrdered holding a copy of the given map. The initial
+// keys are ordered as the map is iterated, which is unspecified.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromMap(map[string]int{"a": 1})
+func DictionaryOrderedFromMap[K comparable, V any](items map[K]V) *DictionaryOrdered[K, V] {
+	dict := DictionaryOrderedEmpty[K, V]()
+	dict.PutAll(items)
+	return dict
+}
+
+// DictionaryOrderedFromPairs creates a new DictionaryOrdered holding the given pairs in their order.
+// When a key is repeated, the last value wins and the key keeps the position of its first occurrence.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	keys := dict.Keys() // keys will be ["b", "a"]
+func DictionaryOrderedFromPairs[K comparable, V any](pairs []Pair[K, V]) *DictionaryOrdered[K, V] {
+	dict := DictionaryOrderedEmpty[K, V]()
+	for _, pair := range pairs {
+		dict.Put(pair.key, pair.value)
+	}
+	return dict
+}
+
+// DictionaryOrderedEmpty creates and returns a new, empty DictionaryOrdered.
+//
+// Example usage:
+//
+//	emptyDict := DictionaryOrderedEmpty[string, int]()
+func DictionaryOrderedEmpty[K comparable, V any]() *DictionaryOrdered[K, V] {
+	return &DictionaryOrdered[K, V]{
+		items: make(map[K]V),
+		order: VectorEmpty[K](),
+	}
+}
+
+// unlink drops the given key from the order.
+func (c *DictionaryOrdered[K, V]) unlink(key K) {
+	if index := slices.Index(c.order.items, key); index != -1 {
+		c.order.items = slices.Delete(c.order.items, index, index+1)
+	}
+}
+
+// Size returns the number of key-value pairs in the DictionaryOrdered.
+//
+// Returns:
+//   - An integer representing the number of elements in the DictionaryOrdered.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("a", 1), NewPair("b", 2)})
+//	size := dict.Size() // size will be 2
+func (c *DictionaryOrdered[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Exists checks if the given key exists in the DictionaryOrdered.
+//
+// Parameters:
+//   - key: The key of type K to check for.
+//
+// Returns:
+//   - A boolean indicating whether the key exists.
+//
+// Example usage:
+//
+//	exists := dict.Exists("a")
+func (c *DictionaryOrdered[K, V]) Exists(key K) bool {
+	_, exists := c.items[key]
+	return exists
+}
+
+// Find returns the values of the DictionaryOrdered that satisfy the given predicate function, in insertion order.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - A slice of values of type V that satisfy the predicate function.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("c", 3), NewPair("a", 1), NewPair("b", 2)})
+//	result := dict.Find(func(k string, v int) bool { return v > 1 }) // result will be [3, 2]
+func (c *DictionaryOrdered[K, V]) Find(predicate func(K, V) bool) []V {
+	filter := []V{}
+	for _, k := range c.order.items {
+		if v := c.items[k]; predicate(k, v) {
+			filter = append(filter, v)
+		}
+	}
+	return filter
+}
+
+// FindOne returns the value of the first key-value pair, in insertion order, that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The value of the first matching pair, or the zero value if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	value, found := dict.FindOne(func(k string, v int) bool { return v > 1 })
+func (c *DictionaryOrdered[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
+	pair, found := c.FindPair(predicate)
+	return pair.value, found
+}
+
+// FindPair returns the first key-value pair, in insertion order, that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The matching Pair[K, V], or a Pair holding zero values if not found.
+//   - A boolean indicating whether a match was found.
+//
+// Example usage:
+//
+//	pair, found := dict.FindPair(func(k string, v int) bool { return v > 1 })
+func (c *DictionaryOrdered[K, V]) FindPair(predicate func(K, V) bool) (Pair[K, V], bool) {
+	for _, k := range c.order.items {
+		if v := c.items[k]; predicate(k, v) {
+			return NewPair(k, v), true
+		}
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
+// Get retrieves the value associated with the given key in the DictionaryOrdered.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//
+// Returns:
+//   - The value associated with the key, or the zero value if the key does not exist.
+//   - A boolean indicating whether the key was found.
+//
+// Example usage:
+//
+//	value, found := dict.Get("a")
+func (c *DictionaryOrdered[K, V]) Get(key K) (V, bool) {
+	value, exists := c.items[key]
+	return value, exists
+}
+
+// GetOrDefault retrieves the value associated with the given key in the DictionaryOrdered, or the fallback if the key
+// does not exist. The fallback is never stored.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key does not exist.
+//
+// Example usage:
+//
+//	value := dict.GetOrDefault("z", 0)
+func (c *DictionaryOrdered[K, V]) GetOrDefault(key K, fallback V) V {
+	if value, exists := c.items[key]; exists {
+		return value
+	}
+	return fallback
+}
+
+// Put adds a key-value pair to the DictionaryOrdered. A new key is appended to the end of the order,
+// while an existing key keeps its position and only has its value updated.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key did not exist.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("a", 1), NewPair("b", 2)})
+//	dict.Put("a", 3) // dict will contain {"a": 3, "b": 2}, in that order
+func (c *DictionaryOrdered[K, V]) Put(key K, item V) (V, bool) {
+	old, exists := c.items[key]
+	if !exists {
+		c.order.Append(key)
+	}
+	c.items[key] = item
+	return old, exists
+}
+
+// PutIfAbsent adds a key-value pair to the DictionaryOrdered only if the key does not already exist.
+//
+// Parameters:
+//   - key: The key of type K to associate with the given value.
+//   - item: The value of type V to be associated with the key if the key is absent.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key was absent.
+//   - A boolean indicating whether the key was already present.
+//
+// Example usage:
+//
+//	old, exists := dict.PutIfAbsent("c", 4)
+func (c *DictionaryOrdered[K, V]) PutIfAbsent(key K, item V) (V, bool) {
+	old, exists := c.items[key]
+	if !exists {
+		c.Put(key, item)
+	}
+	return old, exists
+}
+
+// GetOrPut retrieves the value associated with the given key in the DictionaryOrdered. If the key does not exist,
+// the fallback is stored under the key, at the end of the order, and returned.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V stored and returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key was absent.
+//
+// Example usage:
+//
+//	value := dict.GetOrPut("c", 3)
+func (c *DictionaryOrdered[K, V]) GetOrPut(key K, fallback V) V {
+	value, _ := c.ComputeIfAbsent(key, func(K) V {
+		return fallback
+	})
+	return value
+}
+
+// ComputeIfAbsent retrieves the value associated with the given key in the DictionaryOrdered. If the key does not exist,
+// the factory is invoked with the key and its result is stored, at the end of the order, and returned.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - factory: A function that builds the value of type V for a missing key.
+//
+// Returns:
+//   - The value associated with the key, or the value built by the factory if the key was absent.
+//   - A boolean indicating whether the factory ran, which tells a miss from a hit.
+//
+// Example usage:
+//
+//	value, computed := dict.ComputeIfAbsent("abc", func(k string) int { return len(k) })
+func (c *DictionaryOrdered[K, V]) ComputeIfAbsent(key K, factory func(K) V) (V, bool) {
+	if value, exists := c.items[key]; exists {
+		return value, false
+	}
+	value := factory(key)
+	c.Put(key, value)
+	return value, true
+}
+
+// Compute updates the value associated with the given key in the DictionaryOrdered in a single read-modify-write step.
+// A kept existing key stays in place, a kept new key is appended to the end of the order, and a dropped key is removed.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be computed.
+//   - remap: A function that takes the key, the current value (the zero value if absent) and whether the key exists,
+//     and returns the new value of type V and whether to keep it.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value of type V if the key was removed.
+//   - A boolean indicating whether the key exists after the call.
+//
+// Example usage:
+//
+//	dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return old + 1, true })
+func (c *DictionaryOrdered[K, V]) Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool) {
+	old, exists := c.items[key]
+	value, keep := remap(key, old, exists)
+	if !keep {
+		c.Remove(key)
+		var zero V
+		return zero, false
+	}
+	c.Put(key, value)
+	return value, true
+}
+
+// PutAll adds all key-value pairs from the given map to the DictionaryOrdered, overwriting the existing keys.
+// Go maps have no order, so the new keys are appended in the order the map is iterated.
+//
+// Parameters:
+//   - items: A map of type map[K]V containing the key-value pairs to add.
+//
+// Returns:
+//   - The DictionaryOrdered itself, with all the new key-value pairs added.
+//
+// Example usage:
+//
+//	dict.PutAll(map[string]int{"c": 3})
+func (c *DictionaryOrdered[K, V]) PutAll(items map[K]V) IDictionary[K, V] {
+	for k, v := range items {
+		c.Put(k, v)
+	}
+	return c
+}
+
+// Merge combines all key-value pairs from another IDictionary into the DictionaryOrdered, overwriting the existing keys.
+// The pairs are read through Pairs, so merging another DictionaryOrdered appends its new keys in its own order.
+//
+// Parameters:
+//   - other: The IDictionary to merge into the DictionaryOrdered.
+//
+// Returns:
+//   - The DictionaryOrdered itself, with the key-value pairs from the other dictionary added.
+//
+// Example usage:
+//
+//	dict.Merge(other)
+func (c *DictionaryOrdered[K, V]) Merge(other IDictionary[K, V]) IDictionary[K, V] {
+	for _, pair := range other.Pairs() {
+		c.Put(pair.key, pair.value)
+	}
+	return c
+}
+
+// Filter creates a new DictionaryOrdered holding the key-value pairs that satisfy the given predicate function,
+// keeping their relative order.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - A new DictionaryOrdered containing only the key-value pairs that satisfy the predicate.
+//
+// Example usage:
+//
+//	filtered := dict.Filter(func(k string, v int) bool { return v > 1 })
+func (c *DictionaryOrdered[K, V]) Filter(predicate func(K, V) bool) IDictionary[K, V] {
+	filter := DictionaryOrderedEmpty[K, V]()
+	for _, k := range c.order.items {
+		if v := c.items[k]; predicate(k, v) {
+			filter.Put(k, v)
+		}
+	}
+	return filter
+}
+
+// FilterSelf removes from the DictionaryOrdered the key-value pairs that do not satisfy the given predicate function,
+// keeping the relative order of the remaining ones.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//
+// Returns:
+//   - The DictionaryOrdered itself, with only the key-value pairs that satisfy the predicate.
+//
+// Example usage:
+//
+//	dict.FilterSelf(func(k string, v int) bool { return v > 1 })
+func (c *DictionaryOrdered[K, V]) FilterSelf(predicate func(K, V) bool) IDictionary[K, V] {
+	c.order.items = slices.DeleteFunc(c.order.items, func(k K) bool {
+		if predicate(k, c.items[k]) {
+			return false
+		}
+		delete(c.items, k)
+		return true
+	})
+	return c
+}
+
+// Remove deletes a key-value pair from the DictionaryOrdered and drops the key from the order.
+//
+// Parameters:
+//   - key: The key of type K to remove.
+//
+// Returns:
+//   - The old value associated with the key, or the zero value if the key was not found.
+//   - A boolean indicating whether the key was present and removed.
+//
+// Example usage:
+//
+//	old, exists := dict.Remove("a")
+func (c *DictionaryOrdered[K, V]) Remove(key K) (V, bool) {
+	old, exists := c.items[key]
+	if exists {
+		delete(c.items, key)
+		c.unlink(key)
+	}
+	return old, exists
+}
+
+// PopItem removes the most recently inserted key-value pair from the DictionaryOrdered and returns it.
+//
+// Returns:
+//   - The removed Pair[K, V], or a Pair holding zero values if the DictionaryOrdered is empty.
+//   - A boolean indicating whether a pair was removed.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("a", 1), NewPair("b", 2)})
+//	pair, ok := dict.PopItem() // pair will be (b, 2), ok will be true
+func (c *DictionaryOrdered[K, V]) PopItem() (Pair[K, V], bool) {
+	last := len(c.order.items) - 1
+	if last < 0 {
+		var zero Pair[K, V]
+		return zero, false
+	}
+
+	key := c.order.items[last]
+	value := c.items[key]
+	c.order.items = slices.Delete(c.order.items, last, last+1)
+	delete(c.items, key)
+	return NewPair(key, value), true
+}
+
+// ForEach iterates over all key-value pairs of the DictionaryOrdered in insertion order, applying the provided
+// predicate function to each pair.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and performs an action or operation.
+//
+// Returns:
+//   - The DictionaryOrdered itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	dict.ForEach(func(k string, v int) { fmt.Println(k, v) })
+//	// Output:
+//	// b 2
+//	// a 1
+func (c *DictionaryOrdered[K, V]) ForEach(predicate func(K, V)) IDictionary[K, V] {
+	for _, k := range c.order.items {
+		predicate(k, c.items[k])
+	}
+	return c
+}
+
+// All returns an iterator over the key-value pairs of the DictionaryOrdered in insertion order,
+// to be used with range-over-func and the iter and maps packages.
+//
+// Returns:
+//   - An iter.Seq2 yielding each key together with its value, in insertion order.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	for k, v := range dict.All() {
+//		fmt.Println(k, v) // Prints b 2, a 1
+//	}
+func (c *DictionaryOrdered[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range c.order.items {
+			if !yield(k, c.items[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Map transforms the values of the DictionaryOrdered by applying the provided predicate function to each key-value pair,
+// in insertion order. The order of the keys is not modified.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a new value of type V.
+//
+// Returns:
+//   - The DictionaryOrdered itself, with the transformed values.
+//
+// Example usage:
+//
+//	dict.Map(func(k string, v int) int { return v * 2 })
+func (c *DictionaryOrdered[K, V]) Map(predicate func(K, V) V) IDictionary[K, V] {
+	for _, k := range c.order.items {
+		c.items[k] = predicate(k, c.items[k])
+	}
+	return c
+}
+
+// Clean removes all key-value pairs from the DictionaryOrdered.
+//
+// Returns:
+//   - The DictionaryOrdered itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict.Clean() // dict will be empty: {}
+func (c *DictionaryOrdered[K, V]) Clean() IDictionary[K, V] {
+	c.items = make(map[K]V)
+	c.order = VectorEmpty[K]()
+	return c
+}
+
+// CleanWith removes all key-value pairs from the DictionaryOrdered, calling the provided predicate function
+// with every entry, in insertion order, before the contents are cleared.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and releases or processes the entry.
+//
+// Returns:
+//   - The DictionaryOrdered itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict.CleanWith(func(k string, f *os.File) { f.Close() }) // dict will be empty: {}
+func (c *DictionaryOrdered[K, V]) CleanWith(predicate func(K, V)) IDictionary[K, V] {
+	c.ForEach(predicate)
+	return c.Clean()
+}
+
+// Clone creates a shallow copy of the DictionaryOrdered, including its key-value pairs and their order.
+//
+// Returns:
+//   - A new DictionaryOrdered that is a clone of the current one.
+//
+// Example usage:
+//
+//	cloned := dict.Clone()
+func (c *DictionaryOrdered[K, V]) Clone() IDictionary[K, V] {
+	return &DictionaryOrdered[K, V]{
+		items: maps.Clone(c.items),
+		order: VectorFromList(slices.Clone(c.order.items)),
+	}
+}
+
+// Max returns the key-value pair of the DictionaryOrdered that yields the maximum score when evaluated with the
+// provided predicate function. When several pairs produce the same maximum score, the last one in insertion order
+// is returned, as with Dictionary, but here the result is deterministic.
+//
+// Parameters:
+//   - predicate: A function that takes a key and a value, and returns an integer score used for comparison.
+//
+// Returns:
+//   - A Pair containing the key and value with the maximum score.
+//   - The maximum score returned by the predicate.
+//   - A boolean indicating whether the DictionaryOrdered was non-empty.
+//
+// Example usage:
+//
+//	pair, score, ok := dict.Max(func(k string, v int) int { return v })
+func (c *DictionaryOrdered[K, V]) Max(predicate func(k K, v V) int) (Pair[K, V], int, bool) {
+	return c.best(predicate, func(score, best int) bool {
+		return score >= best
+	})
+}
+
+// Min returns the key-value pair of the DictionaryOrdered that yields the minimum score when evaluated with the
+// provided predicate function. When several pairs produce the same minimum score, the last one in insertion order
+// is returned, as with Dictionary, but here the result is deterministic.
+//
+// Parameters:
+//   - predicate: A function that takes a key and a value, and returns an integer score used for comparison.
+//
+// Returns:
+//   - A Pair containing the key and value with the minimum score.
+//   - The minimum score returned by the predicate.
+//   - A boolean indicating whether the DictionaryOrdered was non-empty.
+//
+// Example usage:
+//
+//	pair, score, ok := dict.Min(func(k string, v int) int { return v })
+func (c *DictionaryOrdered[K, V]) Min(predicate func(k K, v V) int) (Pair[K, V], int, bool) {
+	return c.best(predicate, func(score, best int) bool {
+		return score <= best
+	})
+}
+
+// best returns the pair whose score replaces every previous one according to the given function.
+func (c *DictionaryOrdered[K, V]) best(predicate func(k K, v V) int, replaces func(score, best int) bool) (Pair[K, V], int, bool) {
+	var (
+		bestPair  Pair[K, V]
+		bestScore int
+		init      bool
+	)
+
+	for _, k := range c.order.items {
+		v := c.items[k]
+		score := predicate(k, v)
+		if !init || replaces(score, bestScore) {
+			bestPair = NewPair(k, v)
+			bestScore = score
+			init = true
+		}
+	}
+
+	return bestPair, bestScore, init
+}
+
+// Keys returns a slice of all the keys of the DictionaryOrdered in insertion order.
+//
+// Returns:
+//   - A slice of type []K containing all the keys.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	keys := dict.Keys() // keys will be ["b", "a"]
+func (c *DictionaryOrdered[K, V]) Keys() []K {
+	return slices.Clone(c.order.items)
+}
+
+// KeysVector returns a Vector containing all the keys of the DictionaryOrdered in insertion order.
+//
+// Returns:
+//   - A Vector[K] containing all the keys.
+//
+// Example usage:
+//
+//	keysVector := dict.KeysVector()
+func (c *DictionaryOrdered[K, V]) KeysVector() *Vector[K] {
+	return VectorFromList(c.Keys())
+}
+
+// Values returns a slice containing all the values of the DictionaryOrdered in insertion order of their keys.
+//
+// Returns:
+//   - A slice of type []V containing all the values.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	values := dict.Values() // values will be [2, 1]
+func (c *DictionaryOrdered[K, V]) Values() []V {
+	values := make([]V, 0, len(c.order.items))
+	for _, k := range c.order.items {
+		values = append(values, c.items[k])
+	}
+	return values
+}
+
+// ValuesVector returns a Vector containing all the values of the DictionaryOrdered in insertion order of their keys.
+//
+// Returns:
+//   - A Vector[V] containing all the values.
+//
+// Example usage:
+//
+//	valuesVector := dict.ValuesVector()
+func (c *DictionaryOrdered[K, V]) ValuesVector() *Vector[V] {
+	return VectorFromList(c.Values())
+}
+
+// Pairs returns a slice of the key-value pairs of the DictionaryOrdered in insertion order.
+//
+// Returns:
+//   - A slice of type []Pair[K, V] containing all key-value pairs.
+//
+// Example usage:
+//
+//	dict := DictionaryOrderedFromPairs([]Pair[string, int]{NewPair("b", 2), NewPair("a", 1)})
+//	pairs := dict.Pairs() // pairs will be [(b, 2), (a, 1)]
+func (c *DictionaryOrdered[K, V]) Pairs() []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, len(c.order.items))
+	for _, k := range c.order.items {
+		pairs = append(pairs, NewPair(k, c.items[k]))
+	}
+	return pairs
+}
+
+// Collect returns a new map instance containing all the key-value pairs of the DictionaryOrdered.
+// The map is a shallow copy and, being a Go map, does not keep the insertion order.
+//
+// Returns:
+//   - A map of type map[K]V containing all key-value pairs.
+//
+// Example usage:
+//
+//	collected := dict.Collect()
+func (c *DictionaryOrdered[K, V]) Collect() map[K]V {
+	return maps.Clone(c.items)
+}
+
+// Freeze returns a read-only view of the DictionaryOrdered. The view is backed by the same
+// DictionaryOrdered, so later changes are visible through it and its iterations keep the insertion order.
+//
+// Returns:
+//   - An IReadDictionary[K, V] exposing only the read operations of the DictionaryOrdered.
+//
+// Example usage:
+//
+//	frozen := dict.Freeze()
+func (c *DictionaryOrdered[K, V]) Freeze() IReadDictionary[K, V] {
+	return ImmutableDictionaryFrom[K, V](c)
+}
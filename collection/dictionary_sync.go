@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"iter"
 	"maps"
 	"sync"
 )
@@ -189,16 +190,16 @@ func (c *DictionarySync[K, V]) Find(predicate func(K, V) bool) []V {
 //     The function should return true for the first pair that matches the search criteria.
 //
 // Returns:
-//   - A pointer to the value of type V if a matching key-value pair is found, or nil if not found.
+//   - A copy of the value of type V if a matching key-value pair is found, or the zero value if not found.
 //   - A boolean indicating whether a match was found (true if found, false otherwise).
 //
 // Example usage:
 //
 //	dict := DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
 //	value, found := dict.FindOne(func(k string, v int) bool { return v == 2 })
-//	// value will be a pointer to 2, found will be true
+//	// value will be 2, found will be true
 //	value, found = dict.FindOne(func(k string, v int) bool { return v == 4 })
-//	// value will be nil, found will be false
+//	// value will be 0, found will be false
 func (c *DictionarySync[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -212,21 +213,51 @@ func (c *DictionarySync[K, V]) FindOne(predicate func(K, V) bool) (V, bool) {
 	return zero, false
 }
 
+// FindPair searches for the first key-value pair in the DictionarySync that satisfies the given predicate function.
+// Unlike FindOne, it returns the matching key together with its value. The search runs under the read lock.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and returns a boolean.
+//     The function should return true for the first pair that matches the search criteria.
+//
+// Returns:
+//   - The matching Pair[K, V], or a Pair holding zero values if not found.
+//   - A boolean indicating whether a match was found (true if found, false otherwise).
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//	pair, found := dict.FindPair(func(k string, v int) bool { return v == 2 })
+//	// pair.Key() will be "b", pair.Value() will be 2, found will be true
+func (c *DictionarySync[K, V]) FindPair(predicate func(K, V) bool) (Pair[K, V], bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for k, v := range c.items {
+		if predicate(k, v) {
+			return NewPair(k, v), true
+		}
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
 // Get retrieves the value associated with the given key in the DictionarySync.
-// It returns a pointer to the value if the key exists, and a boolean indicating whether the key was found.
+// The value is returned by copy, so it never aliases the internal map and remains valid
+// after the lock is released, together with a boolean indicating whether the key was found.
 //
 // Parameters:
 //   - key: The key of type K whose associated value is to be retrieved.
 //
 // Returns:
-//   - A pointer to the value of type V associated with the key, or nil if the key does not exist.
+//   - A copy of the value of type V associated with the key, or the zero value if the key does not exist.
 //   - A boolean indicating whether the key was found in the DictionarySync (true if found, false otherwise).
 //
 // Example usage:
 //
 //	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
-//	value, found := dict.Get("a") // value will be a pointer to 1, found will be true
-//	value, found = dict.Get("c")  // value will be nil, found will be false
+//	value, found := dict.Get("a") // value will be 1, found will be true
+//	value, found = dict.Get("c")  // value will be 0, found will be false
 func (c *DictionarySync[K, V]) Get(key K) (V, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -261,6 +292,31 @@ func (c *DictionarySync[K, V]) Put(key K, item V) (V, bool) {
 	return old, exists
 }
 
+// GetOrDefault retrieves the value associated with the given key in the DictionarySync, or the fallback if the key does not exist.
+// It is a pure read under the read lock: the fallback is never stored, so the DictionarySync is left unmodified on a miss.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key does not exist.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1})
+//	value := dict.GetOrDefault("a", 0) // value will be 1
+//	value = dict.GetOrDefault("b", 0)  // value will be 0, dict still contains only "a"
+func (c *DictionarySync[K, V]) GetOrDefault(key K, fallback V) V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if value, exists := c.items[key]; exists {
+		return value
+	}
+	return fallback
+}
+
 // PutIfAbsent adds a key-value pair to the DictionarySync only if the key does not already exist.
 // If the key is already present, it does nothing and returns the existing value associated with the key,
 // along with a boolean indicating that the key was already present.
@@ -289,6 +345,99 @@ func (c *DictionarySync[K, V]) PutIfAbsent(key K, item V) (V, bool) {
 	return old, exists
 }
 
+// GetOrPut retrieves the value associated with the given key in the DictionarySync. If the key does not exist,
+// the fallback is stored under the key and returned. The lookup and the insertion happen under a single write lock.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - fallback: The value of type V stored and returned when the key does not exist.
+//
+// Returns:
+//   - The value associated with the key, or the fallback if the key was absent.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1})
+//	value := dict.GetOrPut("a", 0) // value will be 1, dict is unchanged
+//	value = dict.GetOrPut("b", 2)  // value will be 2, dict will contain {"a": 1, "b": 2}
+func (c *DictionarySync[K, V]) GetOrPut(key K, fallback V) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if value, exists := c.items[key]; exists {
+		return value
+	}
+	c.items[key] = fallback
+	return fallback
+}
+
+// ComputeIfAbsent retrieves the value associated with the given key in the DictionarySync. If the key does not exist,
+// the factory is invoked with the key and its result is stored and returned. The factory is not called when the key exists.
+//
+// The factory runs while holding the write lock, so goroutines racing on the same missing key never build
+// the value twice. As a consequence the factory must not call back into the DictionarySync, and a slow factory
+// blocks every other access until it returns.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be retrieved.
+//   - factory: A function that builds the value of type V for a missing key.
+//
+// Returns:
+//   - The value associated with the key, or the value built by the factory if the key was absent.
+//   - A boolean indicating whether the factory ran, which tells a miss from a hit.
+//
+// Example usage:
+//
+//	dict := DictionarySyncEmpty[string, *os.File]()
+//	file, computed := dict.ComputeIfAbsent("log", func(name string) *os.File { f, _ := os.Create(name); return f })
+//	// computed will be true on the first call and false afterwards
+func (c *DictionarySync[K, V]) ComputeIfAbsent(key K, factory func(K) V) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if value, exists := c.items[key]; exists {
+		return value, false
+	}
+	value := factory(key)
+	c.items[key] = value
+	return value, true
+}
+
+// Compute updates the value associated with the given key in the DictionarySync in a single read-modify-write step.
+// The remap function receives the current value and whether the key exists, and returns the new value and whether
+// the key must be kept. Returning false removes the key, or leaves it absent if it did not exist.
+//
+// The remap function runs while holding the write lock, so concurrent calls on the same key never lose an update.
+// As a consequence the remap function must not call back into the DictionarySync.
+//
+// Parameters:
+//   - key: The key of type K whose associated value is to be computed.
+//   - remap: A function that takes the key, the current value (the zero value if absent) and whether the key exists,
+//     and returns the new value of type V and whether to keep it.
+//
+// Returns:
+//   - The new value associated with the key, or the zero value of type V if the key was removed.
+//   - A boolean indicating whether the key exists after the call.
+//
+// Example usage:
+//
+//	dict := DictionarySyncEmpty[string, int]()
+//	dict.Compute("hits", func(k string, old int, exists bool) (int, bool) { return old + 1, true }) // dict will contain {"hits": 1}
+func (c *DictionarySync[K, V]) Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	old, exists := c.items[key]
+	value, keep := remap(key, old, exists)
+	if !keep {
+		delete(c.items, key)
+		var zero V
+		return zero, false
+	}
+	c.items[key] = value
+	return value, true
+}
+
 // PutAll adds all key-value pairs from another map to the DictionarySync
 // overwriting any existing values for the keys that already exist in the DictionarySync.
 //
@@ -416,6 +565,32 @@ func (c *DictionarySync[K, V]) Remove(key K) (V, bool) {
 	return old, exists
 }
 
+// PopItem removes an arbitrary key-value pair from the DictionarySync and returns it.
+// The lookup and the removal happen under a single write lock, so concurrent callers never pop the same pair.
+// Dictionaries have no specific order, so no guarantee is made about which pair is removed.
+//
+// Returns:
+//   - The removed Pair[K, V], or a Pair holding zero values if the DictionarySync is empty.
+//   - A boolean indicating whether a pair was removed.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
+//	for pair, ok := dict.PopItem(); ok; pair, ok = dict.PopItem() {
+//		fmt.Println(pair.Key(), pair.Value())
+//	} // dict will be empty
+func (c *DictionarySync[K, V]) PopItem() (Pair[K, V], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, value := range c.items {
+		delete(c.items, key)
+		return NewPair(key, value), true
+	}
+	var zero Pair[K, V]
+	return zero, false
+}
+
 // ForEach iterates over all key-value pairs in the DictionarySync, applying the provided predicate function to each pair.
 // The predicate is called with each key and value, allowing side effects or custom actions for every entry in the DictionarySync.
 //
@@ -442,6 +617,35 @@ func (c *DictionarySync[K, V]) ForEach(predicate func(K, V)) IDictionary[K, V] {
 	return c
 }
 
+// All returns an iterator over the key-value pairs of the DictionarySync, to be used with range-over-func
+// and the iter and maps packages. The pairs are yielded in no specific order.
+//
+// The read lock is taken when the iteration starts and held until the loop finishes or breaks, so the
+// iteration sees a consistent state. As a consequence, writing to the DictionarySync inside the loop deadlocks,
+// and even reads inside the loop may block behind a waiting writer; collect what is needed and act after the loop.
+//
+// Returns:
+//   - An iter.Seq2 yielding each key together with its value.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
+//	for k, v := range dict.All() {
+//		fmt.Println(k, v)
+//	}
+func (c *DictionarySync[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		for k, v := range c.items {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
 // Map transforms the values in the DictionarySync by applying the provided predicate function to each key-value pair.
 //
 // Parameters:
@@ -482,6 +686,32 @@ func (c *DictionarySync[K, V]) Clean() IDictionary[K, V] {
 	return c
 }
 
+// CleanWith removes all key-value pairs from the DictionarySync and then calls the provided predicate
+// function with every removed entry. The entries are detached under the write lock, but the predicate
+// runs after the lock is released, so it may safely access the DictionarySync.
+//
+// Parameters:
+//   - predicate: A function that takes a key of type K and a value of type V, and releases or processes the entry.
+//
+// Returns:
+//   - The DictionarySync itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]*os.File{"log": file})
+//	dict.CleanWith(func(k string, f *os.File) { f.Close() }) // dict will be empty: {}
+func (c *DictionarySync[K, V]) CleanWith(predicate func(K, V)) IDictionary[K, V] {
+	c.mu.Lock()
+	items := c.items
+	c.items = make(map[K]V)
+	c.mu.Unlock()
+
+	for k, v := range items {
+		predicate(k, v)
+	}
+	return c
+}
+
 // Clone creates a shallow copy of the DictionarySync, including all key-value pairs.
 // The new DictionarySync will have the same keys and values as the original, but modifications to one
 // will not affect the other.
@@ -715,6 +945,22 @@ func (c *DictionarySync[K, V]) Collect() map[K]V {
 	return maps.Clone(c.items)
 }
 
+// Freeze returns a read-only view of the DictionarySync. The view is backed by the same
+// items, so later changes to the DictionarySync are visible through it, and every read
+// still takes the read lock of the DictionarySync.
+//
+// Returns:
+//   - An IReadDictionary[K, V] exposing only the read operations of the DictionarySync.
+//
+// Example usage:
+//
+//	dict := DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
+//	frozen := dict.Freeze()
+//	value, exists := frozen.Get("a") // value will be 1, exists will be true
+func (c *DictionarySync[K, V]) Freeze() IReadDictionary[K, V] {
+	return ImmutableDictionaryFrom[K, V](c)
+}
+
 // DictionarySyncMap creates a new DictionarySync by applying the provided predicate function to each key-value pair in the original IDictionary.
 // The predicate function is applied to each key and value, and its result is used as the new value in the returned DictionarySync.
 //
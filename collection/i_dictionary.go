@@ -1,23 +1,36 @@
 package collection
 
+import "iter"
+
 type DictionaryConstructor[K comparable, V any, D IDictionary[K, V]] func(map[K]V) D
 
+// IDictionary is the common interface of the dictionary types. The free Dictionary* functions read their
+// sources through Collect, Pairs, Keys or Values, which on a DictionarySync take the lock once and return a
+// snapshot; the lock is not held while the functions run their callbacks.
 type IDictionary[K comparable, V any] interface {
 	Size() int
 	Exists(key K) bool
 	Find(predicate func(K, V) bool) []V
 	FindOne(predicate func(K, V) bool) (V, bool)
+	FindPair(predicate func(K, V) bool) (Pair[K, V], bool)
 	Get(key K) (V, bool)
+	GetOrDefault(key K, fallback V) V
 	Put(key K, item V) (V, bool)
 	PutIfAbsent(key K, item V) (V, bool)
+	GetOrPut(key K, fallback V) V
+	ComputeIfAbsent(key K, factory func(K) V) (V, bool)
+	Compute(key K, remap func(key K, old V, exists bool) (V, bool)) (V, bool)
 	PutAll(items map[K]V) IDictionary[K, V]
 	Merge(other IDictionary[K, V]) IDictionary[K, V]
 	Filter(predicate func(K, V) bool) IDictionary[K, V]
 	FilterSelf(predicate func(K, V) bool) IDictionary[K, V]
 	Remove(key K) (V, bool)
+	PopItem() (Pair[K, V], bool)
 	ForEach(predicate func(K, V)) IDictionary[K, V]
+	All() iter.Seq2[K, V]
 	Map(predicate func(K, V) V) IDictionary[K, V]
 	Clean() IDictionary[K, V]
+	CleanWith(predicate func(K, V)) IDictionary[K, V]
 	Clone() IDictionary[K, V]
 	Max(predicate func(K, V) int) (Pair[K, V], int, bool)
 	Min(predicate func(K, V) int) (Pair[K, V], int, bool)
@@ -27,6 +40,21 @@ type IDictionary[K comparable, V any] interface {
 	ValuesVector() *Vector[V]
 	Pairs() []Pair[K, V]
 	Collect() map[K]V
+	Freeze() IReadDictionary[K, V]
+}
+
+type IReadDictionary[K comparable, V any] interface {
+	Size() int
+	Exists(key K) bool
+	Find(predicate func(K, V) bool) []V
+	FindOne(predicate func(K, V) bool) (V, bool)
+	FindPair(predicate func(K, V) bool) (Pair[K, V], bool)
+	Get(key K) (V, bool)
+	GetOrDefault(key K, fallback V) V
+	ForEach(predicate func(K, V)) IReadDictionary[K, V]
+	Keys() []K
+	Values() []V
+	Pairs() []Pair[K, V]
 }
 
 // IDictionaryMap creates a new IDictionary by applying the provided predicate function to each key-value pair in the original IDictionary.
@@ -121,3 +149,61 @@ func ListMapToIDictionary[I, V any, K comparable, OD IDictionary[K, V]](c []I, p
 
 	return constructor(m)
 }
+
+// ListGroupBy groups the elements of the slice by the key derived from each of them, returning a new IDictionary
+// built by the given constructor, so the caller chooses the backing implementation. Elements keep their original
+// order within each group.
+//
+// Parameters:
+//   - c: The source slice containing elements of type I.
+//   - key: A function that derives the comparable key of type K of an element.
+//   - constructor: A function that instance a new IDictionary implementation, and return it with the grouped values.
+//
+// Returns:
+//   - A new IDictionary[K, *Vector[I]] mapping each key to the Vector of elements producing it.
+//
+// Example usage:
+//
+//	words := []string{"go", "zig", "c", "rust", "odin"}
+//	groups := ListGroupBy(words, func(w string) int { return len(w) }, MakeDictionarySync)
+//	// groups will contain {1: ["c"], 2: ["go"], 3: ["zig"], 4: ["rust", "odin"]}
+func ListGroupBy[I any, K comparable, OD IDictionary[K, *Vector[I]]](c []I, key func(I) K, constructor DictionaryConstructor[K, *Vector[I], OD]) IDictionary[K, *Vector[I]] {
+	groups := make(map[K]*Vector[I])
+	for _, item := range c {
+		k := key(item)
+		group, exists := groups[k]
+		if !exists {
+			group = VectorEmpty[I]()
+			groups[k] = group
+		}
+		group.Append(item)
+	}
+	return constructor(groups)
+}
+
+// VectorFoldInto folds every element of the Vector into an existing IDictionary, updating it in place.
+// For each element the key function selects the entry to update, and the merge function receives the
+// current value of that entry, whether it exists, and the element, returning the new value to store.
+//
+// Each read-merge-write goes through the Compute method of the destination, so on a DictionarySync or a
+// DictionaryCOW it runs under a single write lock and concurrent folds into the same dictionary do not lose updates.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - dst: The IDictionary to be updated.
+//   - key: A function that derives the key of type K for an element.
+//   - merge: A function that combines the current value of the key with the element.
+//
+// Example usage:
+//
+//	counts := DictionaryFromMap(map[string]int{"a": 1})
+//	vec := VectorFromList([]string{"a", "b", "a"})
+//	VectorFoldInto(vec, counts, func(s string) string { return s }, func(v int, _ bool, _ string) int { return v + 1 })
+//	// counts will contain {"a": 3, "b": 1}
+func VectorFoldInto[I any, K comparable, V any](c *Vector[I], dst IDictionary[K, V], key func(I) K, merge func(existing V, exists bool, item I) V) {
+	for _, item := range c.items {
+		dst.Compute(key(item), func(_ K, existing V, exists bool) (V, bool) {
+			return merge(existing, exists, item), true
+		})
+	}
+}
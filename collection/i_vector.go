@@ -1,29 +1,54 @@
 package collection
 
+import (
+	"context"
+	"iter"
+)
+
 type VectorConstructor[I any] func([]I) IVector[I]
 
 type IVector[I any] interface {
 	Size() int
+	CountAll() int
+	Count(predicate func(I) bool) int
 	Contains(predicate func(I) bool) bool
+	Any(predicate func(I) bool) bool
+	All(predicate func(I) bool) bool
 	IndexOf(predicate func(I) bool) int
+	LastIndexOf(predicate func(I) bool) int
+	IndexOfAll(predicate func(I) bool) []int
 	Find(predicate func(I) bool) []I
+	TakeMatching(n int, predicate func(I) bool) *Vector[I]
 	FindOne(predicate func(I) bool) (I, bool)
 	Get(index int) (I, bool)
 	First() (I, bool)
 	Last() (I, bool)
 	Append(items ...I) *Vector[I]
+	Insert(index int, items ...I) (*Vector[I], bool)
 	Set(index int, item I) (I, bool)
+	Swap(i, j int) bool
 	AppendIfAbsent(predicate func(I, I) bool, items ...I) *Vector[I]
 	Merge(other Vector[I]) *Vector[I]
+	MergePtr(other *Vector[I]) *Vector[I]
 	Filter(predicate func(I) bool) *Vector[I]
 	FilterSelf(predicate func(I) bool) *Vector[I]
+	RemoveIf(predicate func(I) bool) int
+	Partition(predicate func(I) bool) (*Vector[I], *Vector[I])
+	PartitionStableSelf(predicate func(I) bool) *Vector[I]
 	Remove(index int) (I, bool)
 	Slice(start, end int) *Vector[I]
 	SliceSelf(start, end int) *Vector[I]
+	View(start, end int) *VectorView[I]
+	CopyWithin(target, start, end int) *Vector[I]
 	Unshift(items ...I) *Vector[I]
 	Shift() (I, bool)
 	JoinBy(indexer func(I) string, predicate func(i, j I) I) *Vector[I]
 	ForEach(predicate func(int, I)) *Vector[I]
+	Enumerate() iter.Seq2[int, I]
+	Values() iter.Seq[I]
+	ForEachParallelErr(workers int, predicate func(int, I) error) []error
+	StreamTo(ctx context.Context, out chan<- I) error
+	Tee(a func(*Vector[I]), b func(*Vector[I])) *Vector[I]
 	Map(predicate func(int, I) I) *Vector[I]
 	Clean() *Vector[I]
 	Clone() *Vector[I]
@@ -31,9 +56,12 @@ type IVector[I any] interface {
 	Max(predicate func(I) int) (I, int, bool)
 	Min(predicate func(I) int) (I, int, bool)
 	Collect() []I
+	CollectExactly(n int) ([]I, bool)
+	CollectCopyCap(extra int) []I
 	Join(separator string) string
 	Pages(size int) int
 	Page(page, size int) *Vector[I]
+	Chunk(size int) []*Vector[I]
 }
 
 // IVectorMap applies the given predicate function to each element in the IVector,
@@ -0,0 +1,33 @@
+package collection
+
+import "fmt"
+
+// IndexedError wraps an error produced while processing the element at a given index of a collection.
+//
+// Fields:
+//   - Index: The position of the element that produced the error.
+//   - Err: The error returned for that element.
+//
+// Example usage:
+//
+//	errs := vec.ForEachParallelErr(4, validate)
+//	for _, err := range errs {
+//		var indexed IndexedError
+//		if errors.As(err, &indexed) {
+//			fmt.Println(indexed.Index, indexed.Err)
+//		}
+//	}
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+// Error returns the message of the wrapped error prefixed with the element index.
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the wrapped error, so errors.Is and errors.As can inspect it.
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
@@ -1,5 +1,9 @@
 package collection
 
+import (
+	"fmt"
+)
+
 // Pair represents a simple key-value pair, where the key is of type K and the value is of type V.
 // This type is useful for storing and working with individual key-value pairs in various contexts, such as in a Dictionary.
 //
@@ -52,4 +56,73 @@ func (p Pair[K, V]) Key() K {
 //     value := pair.Value() // value will be 1
 func (p Pair[K, V]) Value() V {
 	return p.value
-}
\ No newline at end of file
+}
+
+// Swap returns a new Pair with the key and the value exchanged.
+//
+// Returns:
+//   - A Pair[V, K] whose key is the value of the Pair and whose value is its key.
+//
+// Example usage:
+//     pair := NewPair("a", 1)
+//     swapped := pair.Swap() // swapped.Key() will be 1, swapped.Value() will be "a"
+func (p Pair[K, V]) Swap() Pair[V, K] {
+	return NewPair(p.value, p.key)
+}
+
+// String implements fmt.Stringer, formatting the Pair as (key, value).
+//
+// Returns:
+//   - The textual representation of the Pair.
+//
+// Example usage:
+//     pair := NewPair("a", 1)
+//     text := pair.String() // text will be "(a, 1)"
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.key, p.value)
+}
+
+// PairSwap returns a new Pair with the key and the value of the given Pair exchanged.
+// It is the function form of Swap, convenient to pass as a mapping function.
+//
+// Parameters:
+//   - p: The source Pair.
+//
+// Returns:
+//   - A Pair[V, K] whose key is the value of the source Pair and whose value is its key.
+//
+// Example usage:
+//     swapped := VectorMap(pairs, PairSwap[string, int]) // every Pair of swapped will be inverted
+func PairSwap[K, V any](p Pair[K, V]) Pair[V, K] {
+	return p.Swap()
+}
+
+// PairMapKey returns a new Pair whose key is the result of applying the predicate to the key of the given Pair.
+//
+// Parameters:
+//   - p: The source Pair.
+//   - predicate: A function that transforms the key of type K into a key of type E.
+//
+// Returns:
+//   - A new Pair[E, V] with the transformed key and the original value.
+//
+// Example usage:
+//     pair := PairMapKey(NewPair("a", 1), strings.ToUpper) // pair will be {A 1}
+func PairMapKey[K, V, E any](p Pair[K, V], predicate func(K) E) Pair[E, V] {
+	return NewPair(predicate(p.key), p.value)
+}
+
+// PairMapValue returns a new Pair whose value is the result of applying the predicate to the value of the given Pair.
+//
+// Parameters:
+//   - p: The source Pair.
+//   - predicate: A function that transforms the value of type V into a value of type E.
+//
+// Returns:
+//   - A new Pair[K, E] with the original key and the transformed value.
+//
+// Example usage:
+//     pair := PairMapValue(NewPair("a", 1), func(v int) int { return v * 10 }) // pair will be {a 10}
+func PairMapValue[K, V, E any](p Pair[K, V], predicate func(V) E) Pair[K, E] {
+	return NewPair(p.key, predicate(p.value))
+}
@@ -0,0 +1,57 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the Pair as a two-element JSON array [key, value].
+//
+// Returns:
+//   - The JSON encoding of the Pair.
+//   - An error if the key or the value could not be encoded, or nil otherwise.
+//
+// Example usage:
+//
+//	data, err := json.Marshal(NewPair("a", 1))
+//	// data will be ["a",1]
+func (p Pair[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{p.key, p.value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a two-element JSON array [key, value] into the Pair.
+//
+// Parameters:
+//   - data: The JSON array to decode.
+//
+// Returns:
+//   - An error if the input is not an array of exactly two elements holding a key K and a value V, or nil otherwise.
+//
+// Example usage:
+//
+//	var pair Pair[string, int]
+//	err := json.Unmarshal([]byte(`["a",1]`), &pair)
+//	// pair will be (a, 1)
+func (p *Pair[K, V]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("collection: expected a pair of 2 elements but got %d", len(raw))
+	}
+
+	var key K
+	if err := json.Unmarshal(raw[0], &key); err != nil {
+		return err
+	}
+
+	var value V
+	if err := json.Unmarshal(raw[1], &value); err != nil {
+		return err
+	}
+
+	p.key = key
+	p.value = value
+	return nil
+}
@@ -0,0 +1,158 @@
+package collection
+
+// Queue is a generic FIFO collection of elements of type I, backed by a ring buffer.
+// The first element enqueued is the first one dequeued.
+//
+// The ring buffer reuses the slots released by Dequeue, so a steady sequence of
+// Enqueue and Dequeue calls runs without allocating and without growing the backing
+// array. It only grows, doubling its capacity, when it is full.
+//
+// Type parameters:
+//   - I: The type of elements stored in the Queue.
+//
+// Fields:
+//   - items: The ring buffer holding the elements.
+//   - head: The position of the front element in the ring buffer.
+//   - size: The number of elements in the Queue.
+//
+// Example usage:
+//
+//	queue := QueueEmpty[int]()
+//	queue.Enqueue(1).Enqueue(2)
+//	value, exists := queue.Dequeue() // value will be 1, exists will be true
+type Queue[I any] struct {
+	items []I
+	head  int
+	size  int
+}
+
+// QueueFromList creates a new Queue from a given slice of elements.
+// The first element of the slice is the front of the Queue.
+//
+// Parameters:
+//   - items: A slice of elements of type I that will be used to populate the Queue.
+//
+// Returns:
+//   - A pointer to a new Queue[I] containing a copy of the elements of the slice.
+//
+// Example usage:
+//
+//	queue := QueueFromList([]int{1, 2, 3})
+//	value, exists := queue.PeekFront() // value will be 1, exists will be true
+func QueueFromList[I any](items []I) *Queue[I] {
+	queue := &Queue[I]{
+		items: make([]I, len(items)),
+	}
+	return queue.Enqueue(items...)
+}
+
+// QueueEmpty creates and returns a new, empty Queue.
+//
+// Example usage:
+//
+//	queue := QueueEmpty[string]()
+func QueueEmpty[I any]() *Queue[I] {
+	return &Queue[I]{}
+}
+
+// Size returns the number of elements in the Queue.
+//
+// Returns:
+//   - An integer representing the number of elements in the Queue.
+//
+// Example usage:
+//
+//	queue := QueueFromList([]int{1, 2})
+//	size := queue.Size() // size will be 2
+func (c *Queue[I]) Size() int {
+	return c.size
+}
+
+// IsEmpty checks whether the Queue has no elements.
+//
+// Returns:
+//   - A boolean indicating whether the Queue is empty.
+//
+// Example usage:
+//
+//	queue := QueueEmpty[int]()
+//	empty := queue.IsEmpty() // empty will be true
+func (c *Queue[I]) IsEmpty() bool {
+	return c.size == 0
+}
+
+// Enqueue adds the given elements to the back of the Queue, in order.
+//
+// Parameters:
+//   - items: One or more elements of type I to be enqueued.
+//
+// Returns:
+//   - The Queue itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	queue := QueueEmpty[int]()
+//	queue.Enqueue(1, 2).Enqueue(3) // the front of the queue will be 1
+func (c *Queue[I]) Enqueue(items ...I) *Queue[I] {
+	for _, item := range items {
+		if c.size == len(c.items) {
+			c.grow()
+		}
+		c.items[(c.head+c.size)%len(c.items)] = item
+		c.size++
+	}
+	return c
+}
+
+// Dequeue removes and returns the element at the front of the Queue.
+//
+// Returns:
+//   - The element at the front of the Queue, or the zero value if the Queue is empty.
+//   - A boolean indicating whether an element was removed.
+//
+// Example usage:
+//
+//	queue := QueueFromList([]int{1, 2})
+//	value, exists := queue.Dequeue() // value will be 1, exists will be true
+//	value, exists = queue.Dequeue()  // value will be 2, exists will be true
+//	value, exists = queue.Dequeue()  // value will be 0, exists will be false
+func (c *Queue[I]) Dequeue() (I, bool) {
+	var zero I
+	if c.size == 0 {
+		return zero, false
+	}
+
+	item := c.items[c.head]
+	c.items[c.head] = zero
+	c.head = (c.head + 1) % len(c.items)
+	c.size--
+
+	return item, true
+}
+
+// PeekFront returns the element at the front of the Queue without removing it.
+//
+// Returns:
+//   - The element at the front of the Queue, or the zero value if the Queue is empty.
+//   - A boolean indicating whether the Queue has an element.
+//
+// Example usage:
+//
+//	queue := QueueFromList([]int{1, 2})
+//	value, exists := queue.PeekFront() // value will be 1, exists will be true, the queue is unchanged
+func (c *Queue[I]) PeekFront() (I, bool) {
+	if c.size == 0 {
+		var zero I
+		return zero, false
+	}
+	return c.items[c.head], true
+}
+
+func (c *Queue[I]) grow() {
+	items := make([]I, max(1, len(c.items)*2))
+	for i := range c.size {
+		items[i] = c.items[(c.head+i)%len(c.items)]
+	}
+	c.items = items
+	c.head = 0
+}
@@ -0,0 +1,233 @@
+package collection
+
+// Set is a generic collection of distinct elements of type I, backed by a map.
+// Membership checks, insertions and removals run in constant time, and the elements have no specific order.
+//
+// Type parameters:
+//   - I: The type of elements stored in the Set. The elements must be comparable.
+//
+// Fields:
+//   - items: A map whose keys are the elements of the Set.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2, 2, 3})
+//	set.Add(4)
+//	exists := set.Contains(2) // exists will be true, set will contain {1, 2, 3, 4}
+type Set[I comparable] struct {
+	items map[I]struct{}
+}
+
+// SetFromList creates a new Set holding the distinct elements of the given slice.
+//
+// Parameters:
+//   - items: A slice of elements of type I, duplicates are stored once.
+//
+// Returns:
+//   - A pointer to a new Set[I] containing the elements of the slice.
+//
+// Example usage:
+//
+//	set := SetFromList([]string{"a", "b", "a"})
+//	// set will contain {"a", "b"}
+func SetFromList[I comparable](items []I) *Set[I] {
+	set := &Set[I]{
+		items: make(map[I]struct{}, len(items)),
+	}
+	return set.Add(items...)
+}
+
+// SetEmpty creates and returns a new, empty Set.
+//
+// Example usage:
+//
+//	set := SetEmpty[int]()
+func SetEmpty[I comparable]() *Set[I] {
+	return &Set[I]{
+		items: make(map[I]struct{}),
+	}
+}
+
+// Size returns the number of elements in the Set.
+//
+// Returns:
+//   - An integer representing the number of elements in the Set.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2, 2})
+//	size := set.Size() // size will be 2
+func (c *Set[I]) Size() int {
+	return len(c.items)
+}
+
+// Contains checks if the given element is present in the Set.
+//
+// Parameters:
+//   - item: The element of type I to check for.
+//
+// Returns:
+//   - A boolean indicating whether the element is present in the Set.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2})
+//	exists := set.Contains(1) // exists will be true
+//	exists = set.Contains(3)  // exists will be false
+func (c *Set[I]) Contains(item I) bool {
+	_, exists := c.items[item]
+	return exists
+}
+
+// Add inserts the given elements into the Set. Elements already present are ignored.
+//
+// Parameters:
+//   - items: One or more elements of type I to be added.
+//
+// Returns:
+//   - The Set itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	set := SetEmpty[int]()
+//	set.Add(1, 2).Add(2, 3) // set will contain {1, 2, 3}
+func (c *Set[I]) Add(items ...I) *Set[I] {
+	for _, item := range items {
+		c.items[item] = struct{}{}
+	}
+	return c
+}
+
+// Remove deletes the given element from the Set.
+//
+// Parameters:
+//   - item: The element of type I to remove.
+//
+// Returns:
+//   - A boolean indicating whether the element was present and removed.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2})
+//	removed := set.Remove(1) // removed will be true, set will contain {2}
+//	removed = set.Remove(3)  // removed will be false
+func (c *Set[I]) Remove(item I) bool {
+	_, exists := c.items[item]
+	delete(c.items, item)
+	return exists
+}
+
+// Union creates a new Set holding the elements present in the Set, in the other Set, or in both.
+// Neither the receiver nor the other Set is modified.
+//
+// Parameters:
+//   - other: The Set to combine with.
+//
+// Returns:
+//   - A new Set containing the union of both Sets.
+//
+// Example usage:
+//
+//	a := SetFromList([]int{1, 2})
+//	b := SetFromList([]int{2, 3})
+//	union := a.Union(b) // union will contain {1, 2, 3}
+func (c *Set[I]) Union(other *Set[I]) *Set[I] {
+	union := make(map[I]struct{}, len(c.items)+len(other.items))
+	for item := range c.items {
+		union[item] = struct{}{}
+	}
+	for item := range other.items {
+		union[item] = struct{}{}
+	}
+	return &Set[I]{
+		items: union,
+	}
+}
+
+// Intersection creates a new Set holding the elements present in both the Set and the other Set.
+// Neither the receiver nor the other Set is modified.
+//
+// Parameters:
+//   - other: The Set to intersect with.
+//
+// Returns:
+//   - A new Set containing the intersection of both Sets.
+//
+// Example usage:
+//
+//	a := SetFromList([]int{1, 2})
+//	b := SetFromList([]int{2, 3})
+//	intersection := a.Intersection(b) // intersection will contain {2}
+func (c *Set[I]) Intersection(other *Set[I]) *Set[I] {
+	intersection := make(map[I]struct{})
+	for item := range c.items {
+		if other.Contains(item) {
+			intersection[item] = struct{}{}
+		}
+	}
+	return &Set[I]{
+		items: intersection,
+	}
+}
+
+// Difference creates a new Set holding the elements of the Set that are not present in the other Set.
+// Neither the receiver nor the other Set is modified.
+//
+// Parameters:
+//   - other: The Set whose elements are excluded.
+//
+// Returns:
+//   - A new Set containing the difference between both Sets.
+//
+// Example usage:
+//
+//	a := SetFromList([]int{1, 2})
+//	b := SetFromList([]int{2, 3})
+//	difference := a.Difference(b) // difference will contain {1}
+func (c *Set[I]) Difference(other *Set[I]) *Set[I] {
+	difference := make(map[I]struct{})
+	for item := range c.items {
+		if !other.Contains(item) {
+			difference[item] = struct{}{}
+		}
+	}
+	return &Set[I]{
+		items: difference,
+	}
+}
+
+// ForEach applies the given predicate function to each element of the Set, in no specific order.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and performs an action or operation.
+//
+// Returns:
+//   - The Set itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2})
+//	set.ForEach(func(i int) { fmt.Println(i) })
+func (c *Set[I]) ForEach(predicate func(I)) *Set[I] {
+	for item := range c.items {
+		predicate(item)
+	}
+	return c
+}
+
+// ToVector returns a new Vector holding the elements of the Set, in no specific order.
+//
+// Returns:
+//   - A pointer to a new Vector[I] containing the elements of the Set.
+//
+// Example usage:
+//
+//	set := SetFromList([]int{1, 2})
+//	vec := set.ToVector() // vec will contain [1, 2] in no specific order
+func (c *Set[I]) ToVector() *Vector[I] {
+	items := make([]I, 0, len(c.items))
+	for item := range c.items {
+		items = append(items, item)
+	}
+	return VectorFromList(items)
+}
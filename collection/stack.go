@@ -0,0 +1,136 @@
+package collection
+
+// Stack is a generic LIFO collection of elements of type I, backed by a Vector.
+// The last element pushed is the first one popped.
+//
+// Type parameters:
+//   - I: The type of elements stored in the Stack.
+//
+// Fields:
+//   - items: A Vector holding the elements, the top of the Stack is its last element.
+//
+// Example usage:
+//
+//	stack := StackEmpty[int]()
+//	stack.Push(1).Push(2)
+//	value, exists := stack.Pop() // value will be 2, exists will be true
+type Stack[I any] struct {
+	items *Vector[I]
+}
+
+// StackFromList creates a new Stack from a given slice of elements.
+// The elements are pushed in order, so the last element of the slice is the top of the Stack.
+//
+// Parameters:
+//   - items: A slice of elements of type I that will be used to populate the Stack.
+//
+// Returns:
+//   - A pointer to a new Stack[I] containing the elements of the slice.
+//
+// Example usage:
+//
+//	stack := StackFromList([]int{1, 2, 3})
+//	value, exists := stack.Peek() // value will be 3, exists will be true
+func StackFromList[I any](items []I) *Stack[I] {
+	return &Stack[I]{
+		items: VectorFromList(items),
+	}
+}
+
+// StackEmpty creates and returns a new, empty Stack.
+//
+// Example usage:
+//
+//	stack := StackEmpty[string]()
+func StackEmpty[I any]() *Stack[I] {
+	return &Stack[I]{
+		items: VectorEmpty[I](),
+	}
+}
+
+// Size returns the number of elements in the Stack.
+//
+// Returns:
+//   - An integer representing the number of elements in the Stack.
+//
+// Example usage:
+//
+//	stack := StackFromList([]int{1, 2})
+//	size := stack.Size() // size will be 2
+func (c *Stack[I]) Size() int {
+	return c.items.Size()
+}
+
+// IsEmpty checks whether the Stack has no elements.
+//
+// Returns:
+//   - A boolean indicating whether the Stack is empty.
+//
+// Example usage:
+//
+//	stack := StackEmpty[int]()
+//	empty := stack.IsEmpty() // empty will be true
+func (c *Stack[I]) IsEmpty() bool {
+	return c.items.Size() == 0
+}
+
+// Push adds the given elements to the top of the Stack, in order.
+//
+// Parameters:
+//   - items: One or more elements of type I to be pushed.
+//
+// Returns:
+//   - The Stack itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	stack := StackEmpty[int]()
+//	stack.Push(1, 2).Push(3) // the top of the stack will be 3
+func (c *Stack[I]) Push(items ...I) *Stack[I] {
+	c.items.Append(items...)
+	return c
+}
+
+// Pop removes and returns the element at the top of the Stack.
+//
+// Returns:
+//   - The element at the top of the Stack, or the zero value if the Stack is empty.
+//   - A boolean indicating whether an element was removed.
+//
+// Example usage:
+//
+//	stack := StackFromList([]int{1, 2})
+//	value, exists := stack.Pop() // value will be 2, exists will be true
+//	value, exists = stack.Pop()  // value will be 1, exists will be true
+//	value, exists = stack.Pop()  // value will be 0, exists will be false
+func (c *Stack[I]) Pop() (I, bool) {
+	return c.items.Remove(c.items.Size() - 1)
+}
+
+// Peek returns the element at the top of the Stack without removing it.
+//
+// Returns:
+//   - The element at the top of the Stack, or the zero value if the Stack is empty.
+//   - A boolean indicating whether the Stack has an element.
+//
+// Example usage:
+//
+//	stack := StackFromList([]int{1, 2})
+//	value, exists := stack.Peek() // value will be 2, exists will be true, the stack is unchanged
+func (c *Stack[I]) Peek() (I, bool) {
+	return c.items.Last()
+}
+
+// Clear removes all the elements from the Stack.
+//
+// Returns:
+//   - The Stack itself, now empty, allowing for method chaining.
+//
+// Example usage:
+//
+//	stack := StackFromList([]int{1, 2})
+//	stack.Clear() // stack will be empty
+func (c *Stack[I]) Clear() *Stack[I] {
+	c.items.Clean()
+	return c
+}
@@ -1,10 +1,18 @@
 package collection
 
 import (
+	"cmp"
+	"context"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"iter"
 	"math"
+	"math/rand/v2"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // Vector represents a dynamically-sized array-like collection that holds elements of type I.
@@ -82,6 +90,41 @@ func (c *Vector[I]) Size() int {
 	return len(c.items)
 }
 
+// CountAll returns the number of elements currently stored in the Vector. It is an alias of Size
+// that reads better at the end of a fluent chain.
+//
+// Returns:
+//   - The number of elements in the Vector as an integer.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4})
+//     count := vec.Filter(func(v int) bool { return v > 1 }).CountAll() // count will be 3
+func (c *Vector[I]) CountAll() int {
+	return c.Size()
+}
+
+// Count returns the number of elements in the Vector that satisfy the given predicate function,
+// without building an intermediate slice.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether it should be counted.
+//
+// Returns:
+//   - The number of elements that satisfy the predicate.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4})
+//     evens := vec.Count(func(v int) bool { return v%2 == 0 }) // evens will be 2
+func (c *Vector[I]) Count(predicate func(I) bool) int {
+	count := 0
+	for _, item := range c.items {
+		if predicate(item) {
+			count++
+		}
+	}
+	return count
+}
+
 // Contains checks whether any element in the Vector satisfies the given predicate function.
 // It returns true if there is at least one element that matches the predicate, and false otherwise.
 //
@@ -101,6 +144,43 @@ func (c *Vector[I]) Contains(predicate func(I) bool) bool {
 	return ok
 }
 
+// Any checks whether at least one element in the Vector satisfies the given predicate function.
+// It has the same semantics as Contains, and returns false for an empty Vector.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean.
+//
+// Returns:
+//   - A boolean indicating whether any element satisfies the predicate.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3})
+//     anyEven := vec.Any(func(v int) bool { return v%2 == 0 }) // anyEven will be true
+func (c *Vector[I]) Any(predicate func(I) bool) bool {
+	return c.Contains(predicate)
+}
+
+// All checks whether every element in the Vector satisfies the given predicate function.
+// It stops at the first element that does not, and returns true for an empty Vector.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean.
+//
+// Returns:
+//   - A boolean indicating whether all the elements satisfy the predicate.
+//
+// Example usage:
+//     vec := VectorFromList([]int{2, 4, 6})
+//     allEven := vec.All(func(v int) bool { return v%2 == 0 }) // allEven will be true
+func (c *Vector[I]) All(predicate func(I) bool) bool {
+	for _, item := range c.items {
+		if !predicate(item) {
+			return false
+		}
+	}
+	return true
+}
+
 // IndexOf finds the index of the first element in the Vector that satisfies the given predicate function.
 // It returns the index of the first matching element and a boolean indicating whether such an element exists.
 //
@@ -124,6 +204,49 @@ func (c *Vector[I]) IndexOf(predicate func(I) bool) int {
 	return -1
 }
 
+// LastIndexOf finds the index of the last element in the Vector that satisfies the given predicate function,
+// scanning from the end of the Vector.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether the element meets the condition.
+//
+// Returns:
+//   - The index of the last element that satisfies the predicate, or -1 if no element satisfies it.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 3, 2, 3})
+//     index := vec.LastIndexOf(func(v int) bool { return v == 3 }) // index will be 3
+//     index = vec.LastIndexOf(func(v int) bool { return v == 5 })  // index will be -1
+func (c *Vector[I]) LastIndexOf(predicate func(I) bool) int {
+	for i := len(c.items) - 1; i >= 0; i-- {
+		if predicate(c.items[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOfAll finds the indices of every element in the Vector that satisfies the given predicate function.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether the element meets the condition.
+//
+// Returns:
+//   - The indices of the matching elements in ascending order, or an empty, non-nil slice if no element matches.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 3, 2, 3})
+//     indices := vec.IndexOfAll(func(v int) bool { return v == 3 }) // indices will be [1, 3]
+func (c *Vector[I]) IndexOfAll(predicate func(I) bool) []int {
+	indices := []int{}
+	for i, item := range c.items {
+		if predicate(item) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
 // Find returns a slice of all elements in the Vector that satisfy the given predicate function.
 // It applies the predicate to each element and returns all matching elements in a new slice.
 //
@@ -148,20 +271,52 @@ func (c *Vector[I]) Find(predicate func(I) bool) []I {
 	return filter
 }
 
+// TakeMatching returns a new Vector with up to n elements that satisfy the given predicate function.
+// The Vector is scanned in order and the search stops as soon as n matching elements have been found,
+// so the predicate is not evaluated for the remaining elements.
+//
+// Parameters:
+//   - n: The maximum number of matching elements to collect. Values lower than 1 return an empty Vector.
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether the element meets the condition.
+//
+// Returns:
+//   - A new Vector containing the first n matching elements, or fewer if there are not enough matches.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5, 6})
+//     evens := vec.TakeMatching(2, func(v int) bool { return v%2 == 0 }) // evens will be [2, 4]
+func (c *Vector[I]) TakeMatching(n int, predicate func(I) bool) *Vector[I] {
+	filter := []I{}
+	if n < 1 {
+		return VectorFromList(filter)
+	}
+
+	for _, v := range c.items {
+		if predicate(v) {
+			filter = append(filter, v)
+			if len(filter) == n {
+				break
+			}
+		}
+	}
+	return VectorFromList(filter)
+}
+
 // FindOne searches for the first element in the Vector that satisfies the given predicate function.
-// It returns a pointer to the first matching element and a boolean indicating whether such an element was found.
+// It returns a copy of the first matching element and a boolean indicating whether such an element was found.
+// Modifying the returned value does not affect the Vector, use Set to replace the stored element.
 //
 // Parameters:
 //   - predicate: A function that takes an element of type I and returns a boolean indicating whether the element meets the condition.
 //
 // Returns:
-//   - A pointer to the first element that satisfies the predicate, or nil if no element matches.
+//   - A copy of the first element that satisfies the predicate, or the zero value if no element matches.
 //   - A boolean indicating whether a matching element was found (true if found, false if not).
 //
 // Example usage:
 //     vec := VectorFromList([]int{1, 2, 3, 4})
 //     value, found := vec.FindOne(func(v int) bool { return v == 3 }) // value will be 3, found will be true
-//     value, found := vec.FindOne(func(v int) bool { return v == 5 }) // value will be nil, found will be false
+//     value, found := vec.FindOne(func(v int) bool { return v == 5 }) // value will be 0, found will be false
 func (c *Vector[I]) FindOne(predicate func(I) bool) (I, bool) {
 	for _, v := range c.items {
 		if predicate(v) {
@@ -244,6 +399,29 @@ func (c *Vector[I]) Append(items ...I) *Vector[I] {
 	return c
 }
 
+// Insert places the given elements at the specified index of the Vector, shifting the existing elements from that
+// index to the right. Inserting at index 0 prepends the elements and inserting at Size() appends them.
+//
+// Parameters:
+//   - index: The position where the first inserted element will be placed, within [0, Size()].
+//   - items: One or more elements of type I to be inserted, in order.
+//
+// Returns:
+//   - The current Vector, allowing for method chaining.
+//   - A boolean indicating whether the index was valid. If false, the Vector is left unchanged.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 4})
+//     vec.Insert(1, 2, 3) // vec will be modified to [1, 2, 3, 4]
+//     _, ok := vec.Insert(9, 5) // ok will be false, vec remains [1, 2, 3, 4]
+func (c *Vector[I]) Insert(index int, items ...I) (*Vector[I], bool) {
+	if index < 0 || index > len(c.items) {
+		return c, false
+	}
+	c.items = slices.Insert(c.items, index, items...)
+	return c, true
+}
+
 // Set replaces the element at the specified index in the Vector with a new value and returns a pointer 
 // to the previous element along with a boolean indicating whether the operation was successful.
 //
@@ -272,6 +450,28 @@ func (c *Vector[I]) Set(index int,item I) (I, bool) {
 	return old, exists
 }
 
+// Swap exchanges the elements at the indices i and j of the Vector.
+// Swapping an index with itself is a no-op.
+//
+// Parameters:
+//   - i: The index of the first element.
+//   - j: The index of the second element.
+//
+// Returns:
+//   - A boolean indicating whether both indices were valid. If false, the Vector is left unchanged.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3})
+//     ok := vec.Swap(0, 2) // ok will be true, vec will be modified to [3, 2, 1]
+//     ok = vec.Swap(0, 5)  // ok will be false, vec remains [3, 2, 1]
+func (c *Vector[I]) Swap(i, j int) bool {
+	if i < 0 || i >= len(c.items) || j < 0 || j >= len(c.items) {
+		return false
+	}
+	c.items[i], c.items[j] = c.items[j], c.items[i]
+	return true
+}
+
 // AppendIfAbsent adds one or more elements to the end of the Vector, but only if the element does not already exist
 // based on the provided predicate function. The predicate is used to check whether an element already exists in the Vector.
 // If the element is absent, it will be appended; if present, it will be ignored.
@@ -302,6 +502,11 @@ func (c *Vector[I]) AppendIfAbsent(predicate func(I, I) bool, items ...I) *Vecto
 // Merge combines the elements of another Vector with the current Vector.
 // It appends all elements from the provided Vector to the end of the current Vector and returns the updated Vector.
 //
+// The argument is received by value, which copies the Vector header while still sharing its backing slice
+// with the caller's Vector. The elements are only read, so the argument is never modified.
+//
+// Deprecated: Use MergePtr, which takes the other Vector by pointer and avoids the copy.
+//
 // Parameters:
 //   - other: The Vector whose elements will be appended to the current Vector.
 //
@@ -311,8 +516,28 @@ func (c *Vector[I]) AppendIfAbsent(predicate func(I, I) bool, items ...I) *Vecto
 // Example usage:
 //     vec1 := VectorFromList([]int{1, 2, 3})
 //     vec2 := VectorFromList([]int{4, 5, 6})
-//     vec1.Merge(vec2) // vec1 will now contain [1, 2, 3, 4, 5, 6]
+//     vec1.Merge(*vec2) // vec1 will now contain [1, 2, 3, 4, 5, 6]
 func (c *Vector[I]) Merge(other Vector[I]) *Vector[I] {
+	return c.MergePtr(&other)
+}
+
+// MergePtr appends all the elements of another Vector to the end of the current Vector.
+// The elements are copied into the backing array of the current Vector, so the two Vectors never share
+// memory afterwards and later changes to either one are not visible in the other. Elements that are
+// themselves pointers or reference types still refer to the same data. Merging a Vector with itself
+// duplicates its elements.
+//
+// Parameters:
+//   - other: A pointer to the Vector whose elements will be appended. It is not modified.
+//
+// Returns:
+//   - The updated Vector with elements from both Vectors, allowing for method chaining.
+//
+// Example usage:
+//     vec1 := VectorFromList([]int{1, 2, 3})
+//     vec2 := VectorFromList([]int{4, 5, 6})
+//     vec1.MergePtr(vec2) // vec1 will now contain [1, 2, 3, 4, 5, 6], vec2 remains [4, 5, 6]
+func (c *Vector[I]) MergePtr(other *Vector[I]) *Vector[I] {
 	c.items = append(c.items, other.items...)
 	return c
 }
@@ -341,6 +566,60 @@ func (c *Vector[I]) Filter(predicate func(I) bool) *Vector[I] {
 	return VectorFromList(filter)
 }
 
+// Partition splits the Vector in a single pass into the elements that satisfy the given predicate and those that do not.
+// The order of the elements is preserved in both results, and the original Vector remains unchanged.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether it matches.
+//
+// Returns:
+//   - A new Vector containing the elements that satisfy the predicate.
+//   - A new Vector containing the elements that do not satisfy the predicate.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5, 6})
+//     even, odd := vec.Partition(func(v int) bool { return v%2 == 0 })
+//     // even will contain [2, 4, 6], odd will contain [1, 3, 5]
+func (c *Vector[I]) Partition(predicate func(I) bool) (*Vector[I], *Vector[I]) {
+	matched := make([]I, 0)
+	unmatched := make([]I, 0)
+	for _, item := range c.items {
+		if predicate(item) {
+			matched = append(matched, item)
+		} else {
+			unmatched = append(unmatched, item)
+		}
+	}
+	return VectorFromList(matched), VectorFromList(unmatched)
+}
+
+// PartitionStableSelf reorders the current Vector in place so that the elements satisfying the given
+// predicate come first, preserving the relative order of the elements within both groups.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether it must be moved to the front.
+//
+// Returns:
+//   - The updated Vector. The original Vector is directly modified.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5, 6})
+//     vec.PartitionStableSelf(func(v int) bool { return v%2 == 0 }) // vec will be modified to [2, 4, 6, 1, 3, 5]
+func (c *Vector[I]) PartitionStableSelf(predicate func(I) bool) *Vector[I] {
+	rest := make([]I, 0)
+	front := 0
+	for _, item := range c.items {
+		if predicate(item) {
+			c.items[front] = item
+			front++
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	copy(c.items[front:], rest)
+	return c
+}
+
 // FilterSelf modifies the current Vector by retaining only the elements that satisfy the given predicate function.
 // It applies the predicate to each element in the Vector and updates the Vector to include only the matching elements.
 //
@@ -365,20 +644,38 @@ func (c *Vector[I]) FilterSelf(predicate func(I) bool) *Vector[I] {
 	return c
 }
 
-// Remove deletes the element at the specified index from the Vector and returns a pointer to the removed element 
-// along with a boolean indicating whether the element existed. If the index is out of bounds, it returns nil and false.
+// RemoveIf deletes in place every element that satisfies the given predicate function, keeping
+// the relative order of the remaining elements.
+//
+// Parameters:
+//   - predicate: A function that takes an element of type I and returns a boolean indicating whether the element must be removed.
+//
+// Returns:
+//   - The number of removed elements.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5, 6})
+//     removed := vec.RemoveIf(func(v int) bool { return v%2 == 0 }) // removed will be 3, vec will be [1, 3, 5]
+func (c *Vector[I]) RemoveIf(predicate func(I) bool) int {
+	size := len(c.items)
+	c.items = slices.DeleteFunc(c.items, predicate)
+	return size - len(c.items)
+}
+
+// Remove deletes the element at the specified index from the Vector and returns the removed element
+// along with a boolean indicating whether the element existed. If the index is out of bounds, it returns the zero value and false.
 //
 // Parameters:
 //   - index: The position of the element to be removed in the Vector.
 //
 // Returns:
-//   - A pointer to the removed element, or nil if the index is invalid.
+//   - The removed element, or the zero value if the index is invalid.
 //   - A boolean indicating whether the element was successfully removed.
 //
 // Example usage:
 //     vec := VectorFromList([]int{10, 20, 30, 40})
-//     removed, ok := vec.Remove(2) // removed = &30, ok = true, vec will be modified to [10, 20, 40]
-//     removed, ok = vec.Remove(5)  // removed = nil, ok = false (index out of bounds)
+//     removed, ok := vec.Remove(2) // removed = 30, ok = true, vec will be modified to [10, 20, 40]
+//     removed, ok = vec.Remove(5)  // removed = 0, ok = false (index out of bounds)
 func (c *Vector[I]) Remove(index int) (I, bool) {
 	if index < 0 || index > len(c.items)-1{
 		var zero I
@@ -387,7 +684,7 @@ func (c *Vector[I]) Remove(index int) (I, bool) {
 
 	old, exists := c.Get(index)
 
-	c.items = append(c.items[:index], c.items[index:]...)
+	c.items = append(c.items[:index], c.items[index+1:]...)
 
 	return old, exists
 }
@@ -399,10 +696,11 @@ func (c *Vector[I]) Remove(index int) (I, bool) {
 //
 // Parameters:
 //   - start: The index to begin slicing from (inclusive). If out of bounds, it will be adjusted to 0 or the start of the Vector.
-//   - end: The index to end slicing at (exclusive). If out of bounds, it will be adjusted to the length of the Vector.
+//   - end: The index to end slicing at (exclusive). If out of bounds, it will be adjusted to the length of the Vector,
+//          and if lower than start, the result will be empty.
 //
 // Returns:
-//   - A new Vector containing the sliced elements from the original Vector. The original Vector remains unchanged.
+//   - A new Vector containing a copy of the sliced elements from the original Vector. The original Vector remains unchanged.
 //
 // Example usage:
 //     vec := VectorFromList([]int{1, 2, 3, 4, 5})
@@ -410,16 +708,8 @@ func (c *Vector[I]) Remove(index int) (I, bool) {
 //     slicedVec2 := vec.Slice(0, 2) // slicedVec2 will contain [1, 2]
 //     slicedVec3 := vec.Slice(6, 10) // slicedVec3 will contain []
 func (c *Vector[I]) Slice(start, end int) *Vector[I] {
-	if start < 0 {
-		start = 0
-	}
-	if start > len(c.items)-1 {
-		start = len(c.items)
-	}
-	if end > len(c.items)-1 {
-		end = len(c.items)
-	}
-	return VectorFromList(c.items[start:end])
+	start, end = c.clampRange(start, end)
+	return VectorFromList(slices.Clone(c.items[start:end]))
 }
 
 // SliceSelf modifies the current Vector from a portion of the current Vector, defined by the start and end indices.
@@ -439,16 +729,78 @@ func (c *Vector[I]) Slice(start, end int) *Vector[I] {
 //     vec.Clone().Slice(0, 2) // vec will be modified to [1, 2]
 //     vec.Clone().Slice(6, 10) // vec will be modified to []
 func (c *Vector[I]) SliceSelf(start, end int) *Vector[I] {
+	start, end = c.clampRange(start, end)
+	c.items = c.items[start:end]
+	return c
+}
+
+// View returns a VectorView over the elements of the Vector in the range [start, end), without copying them.
+// The indices are clamped the same way as in Slice.
+//
+// The view aliases the backing array of the Vector: changes made in place on the Vector (Set, Map, Sort...)
+// are visible through the view, until the view is detached with Materialize. Operations that reallocate
+// the Vector, such as an Append beyond its capacity, leave the view reading the previous array.
+//
+// Parameters:
+//   - start: The index to begin the view from (inclusive).
+//   - end: The index to end the view at (exclusive).
+//
+// Returns:
+//   - A pointer to a VectorView sharing memory with the Vector.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//     view := vec.View(1, 4) // view will read [2, 3, 4]
+//     vec.Set(2, 9)          // view will read [2, 9, 4]
+func (c *Vector[I]) View(start, end int) *VectorView[I] {
+	start, end = c.clampRange(start, end)
+	return &VectorView[I]{
+		items: c.items[start:end:end],
+	}
+}
+
+// clampRange adjusts the start and end indices of a range into [0, Size()],
+// making sure end is never lower than start.
+func (c *Vector[I]) clampRange(start, end int) (int, int) {
 	if start < 0 {
 		start = 0
 	}
-	if start > len(c.items)-1 {
+	if start > len(c.items) {
 		start = len(c.items)
 	}
-	if end > len(c.items)-1 {
+	if end > len(c.items) {
 		end = len(c.items)
 	}
-	c.items = c.items[start:end]
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// CopyWithin copies the elements in the range [start, end) to the position target within the same Vector,
+// overwriting the existing elements without changing the size of the Vector. Overlapping ranges are handled
+// correctly in both directions. All indices are clamped into [0, Size()], and the copy stops at the end of the Vector.
+//
+// Parameters:
+//   - target: The index where the copied elements are written.
+//   - start: The index of the first element to copy (inclusive).
+//   - end: The index where copying stops (exclusive).
+//
+// Returns:
+//   - The current Vector with the copied elements, allowing for method chaining.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//     vec.CopyWithin(0, 3, 5) // vec will be modified to [4, 5, 3, 4, 5]
+func (c *Vector[I]) CopyWithin(target, start, end int) *Vector[I] {
+	size := len(c.items)
+	target = min(max(target, 0), size)
+	start = min(max(start, 0), size)
+	end = min(max(end, 0), size)
+	if end <= start {
+		return c
+	}
+	copy(c.items[target:], c.items[start:end])
 	return c
 }
 
@@ -541,6 +893,151 @@ func (c *Vector[I]) ForEach(predicate func(int, I)) *Vector[I] {
 	return c
 }
 
+// Enumerate returns an iterator over the indexes and elements of the Vector, to be used with range-over-func
+// and the iter and slices packages. The elements are yielded by value, so the backing array is never exposed.
+//
+// Returns:
+//   - An iter.Seq2 yielding each index together with its element, in order.
+//
+// Example usage:
+//     vec := VectorFromList([]string{"a", "b"})
+//     for i, v := range vec.Enumerate() {
+//         fmt.Println(i, v) // Prints 0 a, 1 b
+//     }
+func (c *Vector[I]) Enumerate() iter.Seq2[int, I] {
+	return func(yield func(int, I) bool) {
+		for i, v := range c.items {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the Vector, to be used with range-over-func
+// and the iter and slices packages. The elements are yielded by value, so the backing array is never exposed.
+//
+// Returns:
+//   - An iter.Seq yielding each element, in order.
+//
+// Example usage:
+//     vec := VectorFromList([]int{3, 1, 2})
+//     sorted := slices.Sorted(vec.Values()) // sorted will be [1, 2, 3], vec is unchanged
+func (c *Vector[I]) Values() iter.Seq[I] {
+	return func(yield func(I) bool) {
+		for _, v := range c.items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachParallelErr applies the given predicate function to each element of the Vector using
+// up to the given number of concurrent workers, and collects every error returned along the way.
+// Unlike a fail-fast loop, all the elements are processed even after a failure.
+//
+// Parameters:
+//   - workers: The maximum number of goroutines processing elements, values lower than 1 are treated as 1.
+//   - predicate: A function that takes the index (int) and an element of type I, and returns an error or nil.
+//     It is called concurrently, so it must be safe for concurrent use.
+//
+// Returns:
+//   - A slice holding an IndexedError for every element whose predicate failed, sorted by index,
+//     or nil if every call succeeded.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, -2, 3, -4})
+//     errs := vec.ForEachParallelErr(2, func(i, v int) error {
+//         if v < 0 {
+//             return fmt.Errorf("negative value %d", v)
+//         }
+//         return nil
+//     }) // errs will hold the errors of the indexes 1 and 3
+func (c *Vector[I]) ForEachParallelErr(workers int, predicate func(int, I) error) []error {
+	workers = max(1, min(workers, len(c.items)))
+
+	results := make([]error, len(c.items))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for i := range indexes {
+				results[i] = predicate(i, c.items[i])
+			}
+		})
+	}
+
+	for i := range c.items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var errs []error
+	for i, err := range results {
+		if err != nil {
+			errs = append(errs, IndexedError{Index: i, Err: err})
+		}
+	}
+	return errs
+}
+
+// StreamTo sends the elements of the Vector, in order, to the given channel. It blocks while the channel is full,
+// so the consumer controls the pace through the buffer size of the channel it provides. The channel is not closed,
+// as it is owned by the caller.
+//
+// Parameters:
+//   - ctx: A context whose cancellation stops the streaming.
+//   - out: The channel receiving the elements.
+//
+// Returns:
+//   - nil if every element was sent, or the error of the context if it was cancelled first.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3})
+//     out := make(chan int, 1)
+//     go func() {
+//         defer close(out)
+//         vec.StreamTo(ctx, out)
+//     }()
+//     for v := range out {
+//         fmt.Println(v)
+//     }
+func (c *Vector[I]) StreamTo(ctx context.Context, out chan<- I) error {
+	for _, item := range c.items {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case out <- item:
+		}
+	}
+	return nil
+}
+
+// Tee passes an independent clone of the Vector to each of the two given functions.
+// Neither function can modify the original Vector or the clone received by the other one.
+//
+// Parameters:
+//   - a: A function that receives its own clone of the Vector.
+//   - b: A function that receives its own clone of the Vector.
+//
+// Returns:
+//   - The current Vector, allowing for method chaining.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3})
+//     vec.Tee(
+//         func(v *Vector[int]) { v.FilterSelf(func(i int) bool { return i > 1 }) },
+//         func(v *Vector[int]) { v.Map(func(_, i int) int { return i * 2 }) },
+//     ) // vec will remain [1, 2, 3]
+func (c *Vector[I]) Tee(a func(*Vector[I]), b func(*Vector[I])) *Vector[I] {
+	a(c.Clone())
+	b(c.Clone())
+	return c
+}
+
 // Map transforms each element in the Vector by applying the given predicate function to it.
 // The predicate function takes both the index (int) and the element (I) as arguments, 
 // and returns a transformed element of the same type I. This method directly modifies 
@@ -716,29 +1213,73 @@ func (c Vector[I]) Collect() []I {
 	return c.items
 }
 
-// Join combines all elements of the Vector into a single string, separated by the specified separator.
-// If the elements of the Vector are already strings, it uses the strings.Join function to join them.
-// Otherwise, it converts each element into a string using fmt.Sprintf and then joins them.
+// CollectExactly returns the elements in the Vector only if it holds exactly n elements.
+// Like Collect, the returned slice gives direct access to the internal items. The length check
+// lets the caller index the result up to n-1 without further bounds checks.
 //
 // Parameters:
-//   - separator: A string that separates the elements in the resulting joined string.
+//   - n: The exact number of elements the Vector is expected to hold.
 //
 // Returns:
-//   - A single string containing all the elements of the Vector, separated by the provided separator.
+//   - A slice of type I containing all elements in the Vector, or nil if the size does not match.
+//   - A boolean indicating whether the Vector holds exactly n elements.
 //
 // Example usage:
-//     vec := VectorFromList([]string{"apple", "banana", "cherry"})
-//     result := vec.Join(", ") // result will be "apple, banana, cherry"
-//
-//     vec2 := VectorFromList([]int{1, 2, 3})
-//     result2 := vec2.Join(" - ") // result2 will be "1 - 2 - 3"
-func (c *Vector[I]) Join(separator string) string {
-	if items, ok := any(c.items).([]string); ok {
-		return strings.Join(items, separator)
+//     vec := VectorFromList([]int{1, 2, 3})
+//     items, ok := vec.CollectExactly(3) // items will be [1, 2, 3], ok will be true
+//     items, ok = vec.CollectExactly(2)  // items will be nil, ok will be false
+func (c *Vector[I]) CollectExactly(n int) ([]I, bool) {
+	if len(c.items) != n {
+		return nil, false
 	}
-	return VectorMap(c, func(i I) string {
-		return fmt.Sprintf("%v", i)
-	}).Join(separator)
+	return c.items, true
+}
+
+// CollectCopyCap returns a copy of the elements in the Vector in a slice with room for extra elements.
+// The returned slice has a length equal to the Vector size and a capacity of Size() + extra, so the
+// caller can append up to extra elements without a reallocation. Negative values of extra are treated as zero.
+//
+// Parameters:
+//   - extra: The number of additional elements the returned slice should be able to hold.
+//
+// Returns:
+//   - A new slice of type I containing all elements in the Vector.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3})
+//     items := vec.CollectCopyCap(2) // items will be [1, 2, 3] with a capacity of 5
+func (c *Vector[I]) CollectCopyCap(extra int) []I {
+	if extra < 0 {
+		extra = 0
+	}
+	items := make([]I, len(c.items), len(c.items)+extra)
+	copy(items, c.items)
+	return items
+}
+
+// Join combines all elements of the Vector into a single string, separated by the specified separator.
+// If the elements of the Vector are already strings, it uses the strings.Join function to join them.
+// Otherwise, it converts each element into a string using fmt.Sprintf and then joins them.
+//
+// Parameters:
+//   - separator: A string that separates the elements in the resulting joined string.
+//
+// Returns:
+//   - A single string containing all the elements of the Vector, separated by the provided separator.
+//
+// Example usage:
+//     vec := VectorFromList([]string{"apple", "banana", "cherry"})
+//     result := vec.Join(", ") // result will be "apple, banana, cherry"
+//
+//     vec2 := VectorFromList([]int{1, 2, 3})
+//     result2 := vec2.Join(" - ") // result2 will be "1 - 2 - 3"
+func (c *Vector[I]) Join(separator string) string {
+	if items, ok := any(c.items).([]string); ok {
+		return strings.Join(items, separator)
+	}
+	return VectorMap(c, func(i I) string {
+		return fmt.Sprintf("%v", i)
+	}).Join(separator)
 }
 
 // Pages calculates the number of pages required to hold all the elements of the Vector,
@@ -786,6 +1327,30 @@ func (c *Vector[I]) Page(page, size int) *Vector[I] {
 	return c.Slice(start, end)
 }
 
+// Chunk splits the Vector into consecutive sub-Vectors of at most size elements, where the last one may be smaller.
+// Each chunk is an independent copy, so the original Vector is not affected by changes to them.
+//
+// Parameters:
+//   - size: The maximum number of elements per chunk.
+//
+// Returns:
+//   - A slice of new Vectors holding the chunks in order, or an empty slice if size is lower than 1 or the Vector is empty.
+//
+// Example usage:
+//     vec := VectorFromList([]int{1, 2, 3, 4, 5, 6, 7})
+//     chunks := vec.Chunk(3) // chunks will contain [1, 2, 3], [4, 5, 6] and [7]
+func (c *Vector[I]) Chunk(size int) []*Vector[I] {
+	if size < 1 {
+		return []*Vector[I]{}
+	}
+
+	chunks := make([]*Vector[I], 0, c.Pages(size))
+	for start := 0; start < len(c.items); start += size {
+		chunks = append(chunks, c.Slice(start, start+size))
+	}
+	return chunks
+}
+
 // VectorMap applies the given predicate function to each element in the IVector,
 // transforming each element of type I into an element of type K, and returns
 // a new Vector with the transformed elements.
@@ -825,3 +1390,903 @@ func VectorMap[I, K any](c IVector[I], predicate func(I) K) IVector[K] {
 func MapToVector[I, K any](c []I, predicate func(I) K) IVector[K] {
 	return MapToIVector(c, predicate, MakeVector)
 }
+
+// VectorSortedIndexOf searches a sorted Vector for the given target using binary search.
+// The Vector must be sorted in ascending order according to the provided comparison function,
+// otherwise the result is undefined.
+//
+// Unlike a general binary search, a miss does not report an insertion point: it returns -1 and false.
+//
+// Parameters:
+//   - c: The sorted Vector to search.
+//   - target: The element to look for.
+//   - cmp: A function that returns a negative number when a < b, zero when a == b, and a positive number when a > b.
+//
+// Returns:
+//   - The index of a matching element, or -1 if no element matches.
+//   - A boolean indicating whether a matching element was found.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 3, 5, 7})
+//	index, found := VectorSortedIndexOf(vec, 5, cmp.Compare[int]) // index will be 2, found will be true
+//	index, found = VectorSortedIndexOf(vec, 4, cmp.Compare[int])  // index will be -1, found will be false
+func VectorSortedIndexOf[I any](c *Vector[I], target I, cmp func(a, b I) int) (int, bool) {
+	low, high := 0, len(c.items)-1
+	for low <= high {
+		mid := int(uint(low+high) >> 1)
+		result := cmp(c.items[mid], target)
+		if result == 0 {
+			return mid, true
+		}
+		if result < 0 {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+	return -1, false
+}
+
+// VectorMapAccum applies a stateful transformation to each element of the Vector, threading an
+// accumulator through the elements from left to right while collecting one output per element.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - state: The initial value of the accumulator.
+//   - predicate: A function that receives the current accumulator and an element, and returns
+//     the next accumulator together with the output for that element.
+//
+// Returns:
+//   - The final value of the accumulator.
+//   - A new Vector containing the outputs in the order of the source elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"a", "b", "c"})
+//	next, labeled := VectorMapAccum(vec, 1, func(id int, s string) (int, string) { return id + 1, fmt.Sprintf("%d:%s", id, s) })
+//	// next will be 4, labeled will contain ["1:a", "2:b", "3:c"]
+func VectorMapAccum[I, S, O any](c *Vector[I], state S, predicate func(S, I) (S, O)) (S, *Vector[O]) {
+	outputs := make([]O, len(c.items))
+	for i, item := range c.items {
+		state, outputs[i] = predicate(state, item)
+	}
+	return state, VectorFromList(outputs)
+}
+
+// VectorPairsUnzip splits a Vector of pairs into a slice of keys and a slice of values.
+// Both slices are aligned, so the key at index i belongs to the value at index i.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type Pair[K, V].
+//
+// Returns:
+//   - A slice containing the key of every pair, in order.
+//   - A slice containing the value of every pair, in order.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]Pair[string, int]{NewPair("a", 1), NewPair("b", 2)})
+//	keys, values := VectorPairsUnzip(vec)
+//	// keys will be ["a", "b"], values will be [1, 2]
+func VectorPairsUnzip[K, V any](c *Vector[Pair[K, V]]) ([]K, []V) {
+	keys := make([]K, len(c.items))
+	values := make([]V, len(c.items))
+	for i, pair := range c.items {
+		keys[i] = pair.key
+		values[i] = pair.value
+	}
+	return keys, values
+}
+
+// VectorDiff compares two Vectors as multisets, so repeated elements are matched one by one.
+//
+// Parameters:
+//   - older: The original Vector.
+//   - newer: The updated Vector.
+//
+// Returns:
+//   - A new Vector with the elements only present in newer, in the order of newer.
+//   - A new Vector with the elements only present in older, in the order of older.
+//   - A new Vector with the elements present in both, in the order of newer.
+//
+// Example usage:
+//
+//	older := VectorFromList([]int{1, 2, 2, 3})
+//	newer := VectorFromList([]int{2, 3, 4})
+//	added, removed, common := VectorDiff(older, newer)
+//	// added will be [4], removed will be [1, 2], common will be [2, 3]
+func VectorDiff[I comparable](older, newer *Vector[I]) (*Vector[I], *Vector[I], *Vector[I]) {
+	counts := make(map[I]int, len(older.items))
+	for _, item := range older.items {
+		counts[item]++
+	}
+
+	added := []I{}
+	common := []I{}
+	for _, item := range newer.items {
+		if counts[item] > 0 {
+			counts[item]--
+			common = append(common, item)
+			continue
+		}
+		added = append(added, item)
+	}
+
+	removed := []I{}
+	for _, item := range older.items {
+		if counts[item] > 0 {
+			counts[item]--
+			removed = append(removed, item)
+		}
+	}
+
+	return VectorFromList(added), VectorFromList(removed), VectorFromList(common)
+}
+
+// VectorSlidingReduce applies the given function to every sliding window of the given size over the Vector,
+// collecting one result per window. A Vector of n elements yields n-size+1 windows.
+//
+// The window passed to the function is a view over the elements of the Vector, so it must not be modified
+// or retained after the function returns.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - size: The number of elements in each window. Sizes lower than 1 or greater than Size() yield an empty Vector.
+//   - predicate: A function that reduces a window of elements into a value of type K.
+//
+// Returns:
+//   - A new Vector containing the result for each window, in order.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 4})
+//	sums := VectorSlidingReduce(vec, 2, func(w []int) int { return w[0] + w[1] })
+//	// sums will contain [3, 5, 7]
+func VectorSlidingReduce[I, K any](c *Vector[I], size int, predicate func(window []I) K) *Vector[K] {
+	if size < 1 || size > len(c.items) {
+		return VectorEmpty[K]()
+	}
+
+	results := make([]K, 0, len(c.items)-size+1)
+	for i := 0; i+size <= len(c.items); i++ {
+		results = append(results, predicate(c.items[i:i+size:i+size]))
+	}
+	return VectorFromList(results)
+}
+
+// VectorHistogram counts the elements of the Vector falling into each bucket defined by the given boundaries.
+// For n boundaries there are n+1 buckets: bucket 0 holds the values lower than boundaries[0], bucket i holds the
+// values in [boundaries[i-1], boundaries[i]), and bucket n holds the values greater than or equal to boundaries[n-1].
+// A value equal to a boundary is therefore counted in the bucket that starts at that boundary.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//   - boundaries: The bucket boundaries, which must be sorted in ascending order.
+//
+// Returns:
+//   - A slice with the count of each bucket, or nil if the boundaries are not sorted.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 5, 10, 15, 20})
+//	counts := VectorHistogram(vec, []int{10, 20})
+//	// counts will be [2, 2, 1]
+func VectorHistogram[T Number](c *Vector[T], boundaries []T) []int {
+	if !slices.IsSorted(boundaries) {
+		return nil
+	}
+
+	counts := make([]int, len(boundaries)+1)
+	for _, item := range c.items {
+		bucket := sort.Search(len(boundaries), func(i int) bool {
+			return boundaries[i] > item
+		})
+		counts[bucket]++
+	}
+	return counts
+}
+
+// VectorReplaceSub returns a new Vector where every non-overlapping occurrence of pattern is replaced by replacement.
+// Occurrences are searched from left to right, and the search resumes right after each replaced occurrence.
+// An empty pattern is rejected: the result is an unchanged copy of the source Vector.
+//
+// Parameters:
+//   - c: The source Vector.
+//   - pattern: The sequence of elements to search for.
+//   - replacement: The sequence of elements inserted in place of each occurrence.
+//
+// Returns:
+//   - A new Vector with the occurrences replaced. The source Vector is not modified.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 1, 2})
+//	replaced := VectorReplaceSub(vec, VectorFromList([]int{1, 2}), VectorFromList([]int{9}))
+//	// replaced will contain [9, 3, 9]
+func VectorReplaceSub[I comparable](c, pattern, replacement *Vector[I]) *Vector[I] {
+	if len(pattern.items) == 0 {
+		return c.Clone()
+	}
+
+	items := make([]I, 0, len(c.items))
+	for i := 0; i < len(c.items); {
+		if i+len(pattern.items) <= len(c.items) && slices.Equal(c.items[i:i+len(pattern.items)], pattern.items) {
+			items = append(items, replacement.items...)
+			i += len(pattern.items)
+			continue
+		}
+		items = append(items, c.items[i])
+		i++
+	}
+	return VectorFromList(items)
+}
+
+// VectorDedupWindow returns a new Vector without the elements that repeat an equal element seen within the
+// previous window positions of the source Vector. Only the last window positions are remembered, so memory
+// stays bounded by the window size and duplicates further apart than the window are kept.
+//
+// Parameters:
+//   - c: The source Vector.
+//   - window: The number of previous positions checked for duplicates. Values lower than 1 disable deduplication.
+//
+// Returns:
+//   - A new Vector without the duplicates found inside the window. The source Vector is not modified.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 1, 2, 3, 1})
+//	deduped := VectorDedupWindow(vec, 2)
+//	// deduped will contain [1, 2, 3, 1], the last 1 is 3 positions away from the previous one
+func VectorDedupWindow[I comparable](c *Vector[I], window int) *Vector[I] {
+	if window < 1 {
+		return c.Clone()
+	}
+
+	lastSeen := make(map[I]int, window)
+	items := make([]I, 0, len(c.items))
+	for i, item := range c.items {
+		if expired := i - window - 1; expired >= 0 {
+			if last, ok := lastSeen[c.items[expired]]; ok && last == expired {
+				delete(lastSeen, c.items[expired])
+			}
+		}
+
+		if _, ok := lastSeen[item]; !ok {
+			items = append(items, item)
+		}
+		lastSeen[item] = i
+	}
+	return VectorFromList(items)
+}
+
+// VectorDistinct returns a new Vector without duplicated elements, keeping the first occurrence of each one.
+// The order of first occurrence is preserved and the source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing comparable elements.
+//
+// Returns:
+//   - A new Vector holding each distinct element once.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{3, 1, 3, 2, 1})
+//	distinct := VectorDistinct(vec) // distinct will contain [3, 1, 2]
+func VectorDistinct[I comparable](c *Vector[I]) *Vector[I] {
+	return VectorDistinctBy(c, func(item I) I {
+		return item
+	})
+}
+
+// VectorDistinctBy returns a new Vector without the elements whose derived key was already produced by a previous element,
+// keeping the first occurrence of each key. The order of first occurrence is preserved and the source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - key: A function that derives the comparable key used to detect duplicates.
+//
+// Returns:
+//   - A new Vector holding the first element found for each distinct key.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"go", "rust", "zig", "js", "c"})
+//	distinct := VectorDistinctBy(vec, func(s string) int { return len(s) })
+//	// distinct will contain ["go", "rust", "zig", "c"], "js" shares its length with "go"
+func VectorDistinctBy[I any, K comparable](c *Vector[I], key func(I) K) *Vector[I] {
+	seen := make(map[K]struct{}, len(c.items))
+	items := make([]I, 0, len(c.items))
+	for _, item := range c.items {
+		k := key(item)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		items = append(items, item)
+	}
+	return VectorFromList(items)
+}
+
+// VectorTopK returns the k greatest elements of the Vector, sorted from greatest to smallest.
+// It keeps a bounded min-heap of size k while scanning the elements, which runs in O(n log k)
+// and is much cheaper than sorting the whole Vector when k is small. The source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - k: The number of elements to keep. If k is greater than the size of the Vector, all the elements are returned.
+//   - less: A function that returns true if a is ordered before b.
+//
+// Returns:
+//   - A new Vector holding the k greatest elements in descending order, or an empty Vector if k is lower than 1.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{5, 1, 9, 3, 7})
+//	top := VectorTopK(vec, 3, func(a, b int) bool { return a < b })
+//	// top will contain [9, 7, 5]
+func VectorTopK[I any](c *Vector[I], k int, less func(a, b I) bool) *Vector[I] {
+	k = min(k, len(c.items))
+	if k < 1 {
+		return VectorEmpty[I]()
+	}
+
+	heap := make([]I, 0, k)
+	for _, item := range c.items {
+		if len(heap) < k {
+			heap = append(heap, item)
+			topKSiftUp(heap, len(heap)-1, less)
+			continue
+		}
+		if less(heap[0], item) {
+			heap[0] = item
+			topKSiftDown(heap, 0, less)
+		}
+	}
+
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		topKSiftDown(heap[:end], 0, less)
+	}
+
+	return VectorFromList(heap)
+}
+
+func topKSiftUp[I any](heap []I, i int, less func(a, b I) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(heap[i], heap[parent]) {
+			return
+		}
+		heap[i], heap[parent] = heap[parent], heap[i]
+		i = parent
+	}
+}
+
+func topKSiftDown[I any](heap []I, i int, less func(a, b I) bool) {
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(heap) && less(heap[left], heap[smallest]) {
+			smallest = left
+		}
+		if right < len(heap) && less(heap[right], heap[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		heap[i], heap[smallest] = heap[smallest], heap[i]
+		i = smallest
+	}
+}
+
+// VectorStableShuffle returns a new Vector with the elements ordered by a hash of their key and the seed.
+// The position of an element depends only on its key and the seed, not on the order of the input, so the
+// same elements always produce the same shuffled order for a given seed. Elements are hashed with 64-bit FNV-1a,
+// and elements whose hashes collide are ordered by key. The source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - key: A function that derives the string identifying an element.
+//   - seed: The seed mixed into the hash, different seeds produce different orders.
+//
+// Returns:
+//   - A new Vector holding the elements in their shuffled order.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"alice", "bob", "carol"})
+//	shuffled := VectorStableShuffle(vec, func(s string) string { return s }, 42)
+//	// shuffled holds the same order for ["carol", "alice", "bob"] and seed 42
+func VectorStableShuffle[I any](c *Vector[I], key func(I) string, seed uint64) *Vector[I] {
+	type hashed struct {
+		hash uint64
+		key  string
+		item I
+	}
+
+	var suffix [8]byte
+	binary.LittleEndian.PutUint64(suffix[:], seed)
+
+	entries := make([]hashed, len(c.items))
+	for i, item := range c.items {
+		k := key(item)
+		hasher := fnv.New64a()
+		hasher.Write([]byte(k))
+		hasher.Write(suffix[:])
+		entries[i] = hashed{hasher.Sum64(), k, item}
+	}
+
+	slices.SortStableFunc(entries, func(a, b hashed) int {
+		if a.hash != b.hash {
+			return cmp.Compare(a.hash, b.hash)
+		}
+		return strings.Compare(a.key, b.key)
+	})
+
+	items := make([]I, len(entries))
+	for i, entry := range entries {
+		items[i] = entry.item
+	}
+	return VectorFromList(items)
+}
+
+// VectorPercentile returns the p-th percentile of the elements of the Vector, interpolating linearly between
+// the two closest ranks when the percentile falls between them. The elements are sorted on a copy, so the source
+// Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//   - p: The percentile to compute, between 0 and 100 inclusive.
+//
+// Returns:
+//   - The p-th percentile as a float64, or 0 if it cannot be computed.
+//   - A boolean indicating whether the Vector is non-empty and p is within [0, 100], which rejects NaN.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{15, 20, 35, 40, 50})
+//	p40, ok := VectorPercentile(vec, 40) // p40 will be 29, ok will be true
+func VectorPercentile[T Number](c *Vector[T], p float64) (float64, bool) {
+	if len(c.items) == 0 || !(p >= 0 && p <= 100) {
+		return 0, false
+	}
+
+	sorted := slices.Clone(c.items)
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+
+	return float64(sorted[lower])*(1-weight) + float64(sorted[upper])*weight, true
+}
+
+// VectorMedian returns the median of the elements of the Vector, which is its 50th percentile.
+// For an even number of elements it is the mean of the two middle elements. The source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The median as a float64, or 0 if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{7, 1, 4, 3})
+//	median, ok := VectorMedian(vec) // median will be 3.5, ok will be true
+func VectorMedian[T Number](c *Vector[T]) (float64, bool) {
+	return VectorPercentile(c, 50)
+}
+
+// VectorEqualFloat reports whether two float Vectors hold the same number of elements and every pair of
+// elements at the same index differs by at most epsilon. NaN values are never considered equal.
+//
+// Parameters:
+//   - a: The first Vector to compare.
+//   - b: The second Vector to compare.
+//   - epsilon: The maximum absolute difference allowed between aligned elements.
+//
+// Returns:
+//   - A boolean indicating whether both Vectors are equal within the given tolerance.
+//
+// Example usage:
+//
+//	a := VectorFromList([]float64{0.1 + 0.2, 1})
+//	b := VectorFromList([]float64{0.3, 1})
+//	equal := VectorEqualFloat(a, b, 1e-9) // equal will be true
+func VectorEqualFloat(a, b *Vector[float64], epsilon float64) bool {
+	if len(a.items) != len(b.items) {
+		return false
+	}
+	for i, item := range a.items {
+		if !(math.Abs(item-b.items[i]) <= epsilon) {
+			return false
+		}
+	}
+	return true
+}
+
+// VectorVariance returns the sample variance of the elements of the Vector, computed in a single pass
+// with Welford's algorithm, which avoids the precision loss of summing squares on large Vectors.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The sample variance as a float64, or 0 if it cannot be computed.
+//   - A boolean indicating whether the Vector has at least two elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	variance, ok := VectorVariance(vec) // variance will be 4.571428..., ok will be true
+func VectorVariance[T Number](c *Vector[T]) (float64, bool) {
+	if len(c.items) < 2 {
+		return 0, false
+	}
+
+	mean, m2 := 0.0, 0.0
+	for i, item := range c.items {
+		value := float64(item)
+		delta := value - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (value - mean)
+	}
+	return m2 / float64(len(c.items)-1), true
+}
+
+// VectorStdDev returns the sample standard deviation of the elements of the Vector, the square root of VectorVariance.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The sample standard deviation as a float64, or 0 if it cannot be computed.
+//   - A boolean indicating whether the Vector has at least two elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]float64{1, 2, 3, 4})
+//	stddev, ok := VectorStdDev(vec) // stddev will be 1.290994..., ok will be true
+func VectorStdDev[T Number](c *Vector[T]) (float64, bool) {
+	variance, ok := VectorVariance(c)
+	return math.Sqrt(variance), ok
+}
+
+// VectorFlatMap applies the given predicate function to each element of the Vector and concatenates
+// the resulting slices, in order, into a new Vector. Elements producing an empty or nil slice contribute nothing.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - predicate: A function that expands an element of type I into a slice of elements of type K.
+//
+// Returns:
+//   - A new Vector holding all the produced elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"a,b", "", "c"})
+//	tokens := VectorFlatMap(vec, func(s string) []string { return strings.FieldsFunc(s, func(r rune) bool { return r == ',' }) })
+//	// tokens will contain ["a", "b", "c"]
+func VectorFlatMap[I, K any](c *Vector[I], predicate func(I) []K) *Vector[K] {
+	items := make([]K, 0, len(c.items))
+	for _, item := range c.items {
+		items = append(items, predicate(item)...)
+	}
+	return VectorFromList(items)
+}
+
+// VectorZip combines two Vectors element by element into a Vector of Pairs, where each Pair holds the elements
+// found at the same index. When the Vectors have different lengths, the result is truncated to the shorter one
+// and the extra elements of the longer Vector are ignored.
+//
+// Parameters:
+//   - a: The Vector providing the keys of the Pairs.
+//   - b: The Vector providing the values of the Pairs.
+//
+// Returns:
+//   - A new Vector of Pair[A, B] with as many elements as the shorter Vector.
+//
+// Example usage:
+//
+//	keys := VectorFromList([]string{"a", "b", "c"})
+//	values := VectorFromList([]int{1, 2})
+//	zipped := VectorZip(keys, values) // zipped will contain [{a 1}, {b 2}]
+func VectorZip[A, B any](a *Vector[A], b *Vector[B]) *Vector[Pair[A, B]] {
+	return VectorZipWith(a, b, NewPair[A, B])
+}
+
+// VectorZipWith combines two Vectors element by element using the given predicate function. When the Vectors
+// have different lengths, the result is truncated to the shorter one and the extra elements of the longer
+// Vector are ignored.
+//
+// Parameters:
+//   - a: The first source Vector.
+//   - b: The second source Vector.
+//   - predicate: A function that combines the elements found at the same index into an element of type C.
+//
+// Returns:
+//   - A new Vector of elements of type C with as many elements as the shorter Vector.
+//
+// Example usage:
+//
+//	a := VectorFromList([]int{1, 2, 3})
+//	b := VectorFromList([]int{10, 20, 30, 40})
+//	sums := VectorZipWith(a, b, func(x, y int) int { return x + y }) // sums will contain [11, 22, 33]
+func VectorZipWith[A, B, C any](a *Vector[A], b *Vector[B], predicate func(A, B) C) *Vector[C] {
+	size := min(len(a.items), len(b.items))
+	items := make([]C, size)
+	for i := range size {
+		items[i] = predicate(a.items[i], b.items[i])
+	}
+	return VectorFromList(items)
+}
+
+// VectorSum returns the sum of the elements of the Vector, or zero for an empty Vector.
+// The sum is accumulated in type T, so it follows the overflow rules of that type.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The sum of all the elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3})
+//	sum := VectorSum(vec) // sum will be 6
+func VectorSum[T Number](c *Vector[T]) T {
+	var sum T
+	for _, item := range c.items {
+		sum += item
+	}
+	return sum
+}
+
+// VectorMin returns the smallest element of the Vector.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The smallest element, or the zero value if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]float64{2.5, -1, 4})
+//	smallest, ok := VectorMin(vec) // smallest will be -1, ok will be true
+func VectorMin[T Number](c *Vector[T]) (T, bool) {
+	if len(c.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Min(c.items), true
+}
+
+// VectorMax returns the largest element of the Vector.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//
+// Returns:
+//   - The largest element, or the zero value if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]float64{2.5, -1, 4})
+//	largest, ok := VectorMax(vec) // largest will be 4, ok will be true
+func VectorMax[T Number](c *Vector[T]) (T, bool) {
+	if len(c.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Max(c.items), true
+}
+
+// VectorSortedDistinct returns a new Vector with the distinct elements of the Vector sorted in ascending order.
+// It sorts a copy and then compacts the consecutive duplicates, so the source Vector is not modified.
+//
+// Parameters:
+//   - c: The source Vector containing ordered elements.
+//
+// Returns:
+//   - A new Vector holding each distinct element once, in ascending order.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{3, 1, 3, 2, 1})
+//	sorted := VectorSortedDistinct(vec) // sorted will contain [1, 2, 3]
+func VectorSortedDistinct[T cmp.Ordered](c *Vector[T]) *Vector[T] {
+	items := slices.Clone(c.items)
+	slices.Sort(items)
+	return VectorFromList(slices.Clip(slices.Compact(items)))
+}
+
+// VectorMaxBy returns the largest element of the Vector according to the given comparison function.
+// When several elements are equally large, the first one encountered is returned.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - less: A function that returns true if a is ordered before b.
+//
+// Returns:
+//   - The largest element, or the zero value if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"go", "rust", "zig", "java"})
+//	longest, ok := VectorMaxBy(vec, func(a, b string) bool { return len(a) < len(b) })
+//	// longest will be "rust", ok will be true
+func VectorMaxBy[I any](c *Vector[I], less func(a, b I) bool) (I, bool) {
+	return vectorBestBy(c, func(candidate, best I) bool {
+		return less(best, candidate)
+	})
+}
+
+// VectorMinBy returns the smallest element of the Vector according to the given comparison function.
+// When several elements are equally small, the first one encountered is returned.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - less: A function that returns true if a is ordered before b.
+//
+// Returns:
+//   - The smallest element, or the zero value if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]string{"go", "rust", "c", "zig"})
+//	shortest, ok := VectorMinBy(vec, func(a, b string) bool { return len(a) < len(b) })
+//	// shortest will be "c", ok will be true
+func VectorMinBy[I any](c *Vector[I], less func(a, b I) bool) (I, bool) {
+	return vectorBestBy(c, less)
+}
+
+// VectorArgMax returns the index of the largest element of the Vector according to the given comparison function.
+// When several elements are equally large, the index of the first one is returned.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - less: A function that returns true if a is ordered before b.
+//
+// Returns:
+//   - The index of the largest element, or -1 if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{3, 9, 2, 9})
+//	index, ok := VectorArgMax(vec, func(a, b int) bool { return a < b })
+//	// index will be 1, ok will be true
+func VectorArgMax[I any](c *Vector[I], less func(a, b I) bool) (int, bool) {
+	return vectorBestIndexBy(c, func(candidate, best I) bool {
+		return less(best, candidate)
+	})
+}
+
+// VectorArgMin returns the index of the smallest element of the Vector according to the given comparison function.
+// When several elements are equally small, the index of the first one is returned.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - less: A function that returns true if a is ordered before b.
+//
+// Returns:
+//   - The index of the smallest element, or -1 if the Vector is empty.
+//   - A boolean indicating whether the Vector is non-empty.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{3, 1, 2, 1})
+//	index, ok := VectorArgMin(vec, func(a, b int) bool { return a < b })
+//	// index will be 1, ok will be true
+func VectorArgMin[I any](c *Vector[I], less func(a, b I) bool) (int, bool) {
+	return vectorBestIndexBy(c, less)
+}
+
+// vectorBestBy returns the first element for which no later element is better according to the given function.
+func vectorBestBy[I any](c *Vector[I], better func(candidate, best I) bool) (I, bool) {
+	index, ok := vectorBestIndexBy(c, better)
+	if !ok {
+		var zero I
+		return zero, false
+	}
+	return c.items[index], true
+}
+
+// vectorBestIndexBy returns the index of the first element for which no later element is better according to the given function.
+func vectorBestIndexBy[I any](c *Vector[I], better func(candidate, best I) bool) (int, bool) {
+	if len(c.items) == 0 {
+		return -1, false
+	}
+
+	best := 0
+	for i := 1; i < len(c.items); i++ {
+		if better(c.items[i], c.items[best]) {
+			best = i
+		}
+	}
+	return best, true
+}
+
+// VectorMovingAverage returns the average of every window of consecutive elements of the Vector. The sum is updated
+// incrementally, subtracting the element leaving the window and adding the one entering it, so it runs in O(n)
+// regardless of the window size.
+//
+// Parameters:
+//   - c: The source Vector containing numeric elements.
+//   - window: The number of consecutive elements averaged together.
+//
+// Returns:
+//   - A new Vector with Size()-window+1 averages, or an empty Vector if window is lower than 1
+//     or greater than Size().
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//	averages := VectorMovingAverage(vec, 3) // averages will contain [2, 3, 4]
+func VectorMovingAverage[T Number](c *Vector[T], window int) *Vector[float64] {
+	if window < 1 || window > len(c.items) {
+		return VectorEmpty[float64]()
+	}
+
+	averages := make([]float64, 0, len(c.items)-window+1)
+	sum := 0.0
+	for i, item := range c.items {
+		sum += float64(item)
+		if i >= window {
+			sum -= float64(c.items[i-window])
+		}
+		if i >= window-1 {
+			averages = append(averages, sum/float64(window))
+		}
+	}
+	return VectorFromList(averages)
+}
+
+// VectorReservoirSample draws a uniform random sample of at most k elements from a sequence of
+// unknown length in a single pass (Algorithm R), so the sequence is never materialized as a whole.
+// Every element of the sequence ends up in the sample with probability k/n, where n is the number
+// of elements yielded.
+//
+// Parameters:
+//   - items: The sequence to sample from.
+//   - k: The size of the sample.
+//   - r: The random source used to choose the replaced positions.
+//
+// Returns:
+//   - A new Vector holding min(k, n) sampled elements, or an empty Vector if k is less than 1.
+//
+// Example usage:
+//
+//	r := rand.New(rand.NewPCG(1, 2))
+//	sample := VectorReservoirSample(slices.Values([]int{1, 2, 3, 4, 5}), 2, r)
+//	// sample will hold 2 of the 5 elements
+func VectorReservoirSample[I any](items iter.Seq[I], k int, r *rand.Rand) *Vector[I] {
+	if k < 1 {
+		return VectorEmpty[I]()
+	}
+
+	reservoir := make([]I, 0, k)
+	seen := 0
+	for item := range items {
+		seen++
+		if len(reservoir) < k {
+			reservoir = append(reservoir, item)
+			continue
+		}
+		if j := r.IntN(seen); j < k {
+			reservoir[j] = item
+		}
+	}
+
+	return VectorFromList(reservoir)
+}
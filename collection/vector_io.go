@@ -0,0 +1,229 @@
+package collection
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// VectorToCSV writes every element of the Vector as a CSV record to the given writer.
+// Fields containing commas, quotes or line breaks are quoted following RFC 4180.
+//
+// Parameters:
+//   - c: The source Vector, where each element holds the fields of one record.
+//   - w: The writer receiving the CSV output.
+//
+// Returns:
+//   - An error if any record could not be written, or nil otherwise.
+//
+// Example usage:
+//
+//	vec := VectorFromList([][]string{{"name", "note"}, {"go", "fast, simple"}})
+//	err := VectorToCSV(vec, os.Stdout)
+//	// name,note
+//	// go,"fast, simple"
+func VectorToCSV(c *Vector[[]string], w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(c.items); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// VectorFromCSV reads every CSV record from the given reader into a new Vector.
+// Records are not required to have the same number of fields.
+//
+// Parameters:
+//   - r: The reader providing the CSV input.
+//
+// Returns:
+//   - A pointer to a new Vector holding one element per record.
+//   - An error if the input is not valid CSV, or nil otherwise.
+//
+// Example usage:
+//
+//	vec, err := VectorFromCSV(strings.NewReader("a,b\nc,\"d,e\"\n"))
+//	// vec will contain [["a", "b"], ["c", "d,e"]]
+func VectorFromCSV(r io.Reader) (*Vector[[]string], error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if records == nil {
+		records = make([][]string, 0)
+	}
+	return VectorFromList(records), nil
+}
+
+// VectorWriteJSONL writes every element of the Vector to the given writer in JSON Lines format,
+// one JSON-encoded element per line. Each element is written as soon as it is encoded, so the
+// whole document is never held in memory.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - w: The writer receiving the JSON Lines output.
+//
+// Returns:
+//   - An error if any element could not be encoded or written, or nil otherwise.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3})
+//	err := VectorWriteJSONL(vec, os.Stdout)
+//	// 1
+//	// 2
+//	// 3
+func VectorWriteJSONL[I any](c *Vector[I], w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, item := range c.items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VectorReadJSONL reads JSON Lines from the given reader into a new Vector, decoding one element per line.
+//
+// Parameters:
+//   - r: The reader providing the JSON Lines input.
+//
+// Returns:
+//   - A pointer to a new Vector holding the decoded elements in order.
+//   - An error if any line could not be decoded into type I, or nil otherwise.
+//
+// Example usage:
+//
+//	vec, err := VectorReadJSONL[int](strings.NewReader("1\n2\n3\n"))
+//	// vec will contain [1, 2, 3]
+func VectorReadJSONL[I any](r io.Reader) (*Vector[I], error) {
+	decoder := json.NewDecoder(r)
+	items := make([]I, 0)
+	for {
+		var item I
+		err := decoder.Decode(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return VectorFromList(items), nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Vector as a plain JSON array of its elements.
+// An empty Vector is encoded as [] rather than null.
+//
+// Returns:
+//   - The JSON encoding of the elements.
+//   - An error if any element could not be encoded, or nil otherwise.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3})
+//	data, err := json.Marshal(vec)
+//	// data will be [1,2,3]
+func (c *Vector[I]) MarshalJSON() ([]byte, error) {
+	if c.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(c.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON array into the Vector and replacing
+// its current elements. A JSON null leaves the Vector empty.
+//
+// Parameters:
+//   - data: The JSON array to decode.
+//
+// Returns:
+//   - An error if the input is not an array of elements of type I, or nil otherwise.
+//
+// Example usage:
+//
+//	vec := VectorEmpty[int]()
+//	err := json.Unmarshal([]byte("[1,2,3]"), vec)
+//	// vec will contain [1, 2, 3]
+func (c *Vector[I]) UnmarshalJSON(data []byte) error {
+	items := make([]I, 0)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		items = make([]I, 0)
+	}
+	c.items = items
+	return nil
+}
+
+// VectorEncode writes the Vector to the given writer as a length prefix followed by every element encoded by the
+// provided function, so the wire format of the elements is chosen by the caller. The length is written as a
+// big-endian uint64.
+//
+// Parameters:
+//   - c: The source Vector containing elements of type I.
+//   - w: The writer receiving the encoded output.
+//   - encode: A function that writes a single element to the writer.
+//
+// Returns:
+//   - An error if the length or any element could not be written, or nil otherwise.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int32{1, 2, 3})
+//	err := VectorEncode(vec, &buffer, func(w io.Writer, v int32) error { return binary.Write(w, binary.BigEndian, v) })
+func VectorEncode[I any](c *Vector[I], w io.Writer, encode func(io.Writer, I) error) error {
+	if err := binary.Write(w, binary.BigEndian, uint64(len(c.items))); err != nil {
+		return err
+	}
+	for _, item := range c.items {
+		if err := encode(w, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VectorDecode reads a Vector written by VectorEncode from the given reader, decoding the number of elements
+// announced by the length prefix with the provided function.
+//
+// Parameters:
+//   - r: The reader providing the encoded input.
+//   - decode: A function that reads a single element from the reader.
+//
+// Returns:
+//   - A pointer to a new Vector holding the decoded elements in order.
+//   - An error if the length or any element could not be read, or nil otherwise.
+//
+// Example usage:
+//
+//	vec, err := VectorDecode(&buffer, func(r io.Reader) (int32, error) {
+//		var v int32
+//		err := binary.Read(r, binary.BigEndian, &v)
+//		return v, err
+//	})
+//	// vec will contain [1, 2, 3]
+func VectorDecode[I any](r io.Reader, decode func(io.Reader) (I, error)) (*Vector[I], error) {
+	var size uint64
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+
+	items := make([]I, 0)
+	for range size {
+		item, err := decode(r)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return VectorFromList(items), nil
+}
@@ -0,0 +1,113 @@
+package collection
+
+// VectorUniqueBuilder incrementally builds a Vector of distinct elements. Alongside the elements it
+// keeps a set of the elements already added, so each membership check is O(1) instead of the O(n)
+// scan done by Vector.AppendIfAbsent, turning a deduplicating build of n elements from O(n²) into O(n).
+//
+// Type parameters:
+//   - I: The type of elements stored in the builder. It must be comparable to be kept in the set.
+//
+// Fields:
+//   - items: A slice holding the distinct elements in insertion order.
+//   - seen: A set of the elements already present in items.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderEmpty[int]()
+//	builder.Add(1, 2, 2, 3, 1)
+//	vec := builder.Build() // vec will contain [1, 2, 3]
+type VectorUniqueBuilder[I comparable] struct {
+	items []I
+	seen  map[I]struct{}
+}
+
+// VectorUniqueBuilderEmpty creates and returns a new, empty VectorUniqueBuilder.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderEmpty[string]()
+func VectorUniqueBuilderEmpty[I comparable]() *VectorUniqueBuilder[I] {
+	return &VectorUniqueBuilder[I]{
+		items: make([]I, 0),
+		seen:  make(map[I]struct{}),
+	}
+}
+
+// VectorUniqueBuilderFromList creates a new VectorUniqueBuilder holding the distinct elements of the given slice.
+//
+// Parameters:
+//   - items: A slice of elements of type I, duplicates are discarded keeping the first occurrence.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderFromList([]int{1, 1, 2})
+//	vec := builder.Build() // vec will contain [1, 2]
+func VectorUniqueBuilderFromList[I comparable](items []I) *VectorUniqueBuilder[I] {
+	return VectorUniqueBuilderEmpty[I]().Add(items...)
+}
+
+// Add appends the given elements that are not already present in the builder, in O(1) per element.
+//
+// Parameters:
+//   - items: One or more elements of type I to be appended if absent.
+//
+// Returns:
+//   - The builder itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderEmpty[int]()
+//	builder.Add(1, 2).Add(2, 3) // builder will hold [1, 2, 3]
+func (c *VectorUniqueBuilder[I]) Add(items ...I) *VectorUniqueBuilder[I] {
+	for _, item := range items {
+		if _, ok := c.seen[item]; ok {
+			continue
+		}
+		c.seen[item] = struct{}{}
+		c.items = append(c.items, item)
+	}
+	return c
+}
+
+// Contains checks whether the given element has already been added to the builder.
+//
+// Parameters:
+//   - item: The element of type I to look for.
+//
+// Returns:
+//   - A boolean indicating whether the element is present.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderFromList([]int{1, 2})
+//	exists := builder.Contains(2) // exists will be true
+func (c *VectorUniqueBuilder[I]) Contains(item I) bool {
+	_, ok := c.seen[item]
+	return ok
+}
+
+// Size returns the number of distinct elements added to the builder.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderFromList([]int{1, 1, 2})
+//	size := builder.Size() // size will be 2
+func (c *VectorUniqueBuilder[I]) Size() int {
+	return len(c.items)
+}
+
+// Build returns a new Vector holding the distinct elements in insertion order.
+// The Vector owns a copy of the elements, so the builder can keep being used afterwards.
+//
+// Returns:
+//   - A pointer to a new Vector[I] containing the distinct elements.
+//
+// Example usage:
+//
+//	builder := VectorUniqueBuilderFromList([]int{3, 1, 3})
+//	vec := builder.Build() // vec will contain [3, 1]
+func (c *VectorUniqueBuilder[I]) Build() *Vector[I] {
+	items := make([]I, len(c.items))
+	copy(items, c.items)
+	return VectorFromList(items)
+}
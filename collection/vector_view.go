@@ -0,0 +1,91 @@
+package collection
+
+import "slices"
+
+// VectorView is a read-only window over a range of a Vector that shares its backing array.
+// It is meant for transient processing of large Vectors, where copying the range with Slice
+// would be wasteful. Use Materialize to detach the elements into an independent Vector.
+//
+// Type parameters:
+//   - I: The type of elements in the view.
+//
+// Fields:
+//   - items: A slice aliasing the range of the parent Vector.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3, 4, 5})
+//	view := vec.View(1, 4)
+//	value, exists := view.Get(0) // value will be 2, exists will be true
+type VectorView[I any] struct {
+	items []I
+}
+
+// Size returns the number of elements in the VectorView.
+//
+// Returns:
+//   - An integer representing the number of elements in the view.
+//
+// Example usage:
+//
+//	view := VectorFromList([]int{1, 2, 3, 4, 5}).View(1, 4)
+//	size := view.Size() // size will be 3
+func (c *VectorView[I]) Size() int {
+	return len(c.items)
+}
+
+// Get retrieves the element at the specified index of the VectorView, relative to the start of the view.
+//
+// Parameters:
+//   - index: The position of the element to retrieve.
+//
+// Returns:
+//   - The element at the given index, or the zero value if the index is out of bounds.
+//   - A boolean indicating whether the index is valid.
+//
+// Example usage:
+//
+//	view := VectorFromList([]int{1, 2, 3, 4, 5}).View(1, 4)
+//	value, exists := view.Get(2) // value will be 4, exists will be true
+//	value, exists = view.Get(3)  // value will be 0, exists will be false
+func (c *VectorView[I]) Get(index int) (I, bool) {
+	if index >= 0 && index < len(c.items) {
+		return c.items[index], true
+	}
+	var zero I
+	return zero, false
+}
+
+// ForEach applies the given predicate function to each element of the VectorView, in order.
+//
+// Parameters:
+//   - predicate: A function that takes the index relative to the view and an element of type I.
+//
+// Returns:
+//   - The VectorView itself, allowing for method chaining.
+//
+// Example usage:
+//
+//	view := VectorFromList([]int{1, 2, 3, 4, 5}).View(1, 4)
+//	view.ForEach(func(i, v int) { fmt.Println(i, v) }) // prints 0 2, 1 3, 2 4
+func (c *VectorView[I]) ForEach(predicate func(int, I)) *VectorView[I] {
+	for i, item := range c.items {
+		predicate(i, item)
+	}
+	return c
+}
+
+// Materialize copies the elements of the VectorView into a new, independent Vector.
+// Later changes to the parent Vector are not visible in the result.
+//
+// Returns:
+//   - A pointer to a new Vector[I] holding a copy of the viewed elements.
+//
+// Example usage:
+//
+//	vec := VectorFromList([]int{1, 2, 3})
+//	detached := vec.View(0, 2).Materialize()
+//	vec.Set(0, 9) // detached will still contain [1, 2]
+func (c *VectorView[I]) Materialize() *Vector[I] {
+	return VectorFromList(slices.Clone(c.items))
+}
@@ -0,0 +1,204 @@
+package collection
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestDictionaryCOWOperations(t *testing.T) {
+	source := map[string]int{"a": 1, "b": 2}
+	dict := collection.DictionaryCOWFromMap(source)
+
+	source["c"] = 3
+	if dict.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, dict.Size())
+	}
+
+	if old, exists := dict.Put("a", 10); !exists || old != 1 {
+		t.Errorf("Expected %d but got %d", 1, old)
+	}
+
+	if _, exists := dict.PutIfAbsent("a", 20); !exists {
+		t.Errorf("Expected key %s to exist", "a")
+	}
+
+	if value, computed := dict.ComputeIfAbsent("abc", func(k string) int { return len(k) }); value != 3 || !computed {
+		t.Errorf("Expected %d computed but got %d, computed %t", 3, value, computed)
+	}
+
+	if _, computed := dict.ComputeIfAbsent("abc", func(k string) int { return 0 }); computed {
+		t.Errorf("Expected the second call not to compute")
+	}
+
+	if old, exists := dict.Remove("b"); !exists || old != 2 {
+		t.Errorf("Expected %d but got %d", 2, old)
+	}
+
+	expected := map[string]int{"a": 10, "abc": 3}
+	collected := dict.Collect()
+	if len(collected) != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), len(collected))
+	}
+	for k, v := range expected {
+		if collected[k] != v {
+			t.Errorf("Expected %d but got %d", v, collected[k])
+		}
+	}
+
+	collected["z"] = 26
+	if dict.Exists("z") {
+		t.Errorf("Expected Collect to return a copy")
+	}
+}
+
+func TestDictionaryCOWSnapshotIteration(t *testing.T) {
+	dict := collection.DictionaryCOWFromMap(map[int]int{1: 1, 2: 2, 3: 3})
+
+	visited := 0
+	dict.ForEach(func(k, v int) {
+		visited++
+		dict.Put(k+100, v)
+	})
+
+	if visited != 3 {
+		t.Errorf("Expected %d but got %d", 3, visited)
+	}
+
+	if dict.Size() != 6 {
+		t.Errorf("Expected %d but got %d", 6, dict.Size())
+	}
+
+	cloned := dict.Clone()
+	dict.Clean()
+
+	if cloned.Size() != 6 || dict.Size() != 0 {
+		t.Errorf("Expected %d and %d but got %d and %d", 6, 0, cloned.Size(), dict.Size())
+	}
+}
+
+func TestDictionaryCOWStress(t *testing.T) {
+	dict := collection.DictionaryCOWEmpty[string, int]()
+
+	n := 2000
+	var wg sync.WaitGroup
+	for i := range n {
+		key := strconv.Itoa(i)
+		wg.Go(func() {
+			dict.Put(key, i)
+		})
+		wg.Go(func() {
+			if value, exists := dict.Get(key); exists && value != i {
+				t.Errorf("Expected %d but got %d", i, value)
+			}
+			dict.Size()
+		})
+		wg.Go(func() {
+			dict.ComputeIfAbsent("shared", func(string) int { return i })
+		})
+	}
+	wg.Wait()
+
+	if dict.Size() != n+1 {
+		t.Errorf("Expected %d but got %d", n+1, dict.Size())
+	}
+
+	for i := range n {
+		if value, _ := dict.Get(strconv.Itoa(i)); value != i {
+			t.Errorf("Expected %d but got %d", i, value)
+		}
+	}
+}
+
+func benchmarkDictionaryReadHeavy(b *testing.B, dict collection.IDictionary[int, int]) {
+	for i := range 1000 {
+		dict.Put(i, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%1000 == 0 {
+				dict.Put(i%1000, i)
+			} else {
+				dict.Get(i % 1000)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkDictionaryCOWReadHeavy(b *testing.B) {
+	benchmarkDictionaryReadHeavy(b, collection.DictionaryCOWEmpty[int, int]())
+}
+
+func BenchmarkDictionarySyncReadHeavy(b *testing.B) {
+	benchmarkDictionaryReadHeavy(b, collection.DictionarySyncEmpty[int, int]())
+}
+
+func TestDictionaryCOWCompute(t *testing.T) {
+	dict := collection.DictionaryCOWEmpty[string, int]()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 100 {
+				dict.Compute("counter", func(_ string, old int, _ bool) (int, bool) {
+					return old + 1, true
+				})
+			}
+		})
+	}
+	wg.Wait()
+
+	if total, _ := dict.Get("counter"); total != 800 {
+		t.Errorf("Expected %d but got %d", 800, total)
+	}
+
+	if _, ok := dict.Compute("counter", func(string, int, bool) (int, bool) { return 0, false }); ok || dict.Exists("counter") {
+		t.Error("Expected key counter to be removed")
+	}
+}
+
+func TestDictionaryCOWVectorFoldInto(t *testing.T) {
+	counts := collection.DictionaryCOWFromMap(map[string]int{"a": 100})
+
+	n := 100
+
+	var wg sync.WaitGroup
+	for range n {
+		wg.Go(func() {
+			vec := collection.VectorFromList([]string{"a", "b"})
+			collection.VectorFoldInto(vec, counts, func(s string) string {
+				return s
+			}, func(existing int, exists bool, item string) int {
+				return existing + 1
+			})
+		})
+	}
+	wg.Wait()
+
+	if result, _ := counts.Get("a"); result != 100+n {
+		t.Errorf("Expected %d but got %d", 100+n, result)
+	}
+
+	if result, _ := counts.Get("b"); result != n {
+		t.Errorf("Expected %d but got %d", n, result)
+	}
+}
+
+func TestDictionaryCOWZeroValue(t *testing.T) {
+	var dict collection.DictionaryCOW[string, int]
+
+	if _, ok := dict.Get("a"); ok || dict.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, dict.Size())
+	}
+
+	dict.Put("a", 1)
+	if value, ok := dict.Get("a"); !ok || value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+}
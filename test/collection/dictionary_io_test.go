@@ -0,0 +1,108 @@
+package collection
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+type jsonLang struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+func TestDictionaryJSONRoundTrip(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"a":1,"b":2}` {
+		t.Errorf("Expected %s but got %s", `{"a":1,"b":2}`, data)
+	}
+
+	result := collection.DictionaryFromMap(map[string]int{"stale": 9})
+	if err := json.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Size() != 2 || result.Exists("stale") {
+		t.Errorf("Expected %d but got %d", 2, result.Size())
+	}
+
+	if value, _ := result.Get("b"); value != 2 {
+		t.Errorf("Expected %d but got %d", 2, value)
+	}
+
+	if data, _ := json.Marshal(collection.DictionaryEmpty[string, int]()); string(data) != "{}" {
+		t.Errorf("Expected %s but got %s", "{}", data)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":"b"}`), result); err == nil {
+		t.Error("Expected an error decoding a string into an int")
+	}
+}
+
+func TestDictionaryJSONStructValues(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[int]jsonLang{
+		1: {"Golang", 30},
+		2: {"Zig", 40},
+	})
+
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := collection.DictionaryEmpty[int, jsonLang]()
+	if err := json.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, expected := range dict.Collect() {
+		if value, _ := result.Get(key); value != expected {
+			t.Errorf("Expected %v but got %v", expected, value)
+		}
+	}
+}
+
+func TestDictionarySyncJSONRoundTrip(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2})
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			dict.Put("c", i)
+		})
+		wg.Go(func() {
+			if _, err := json.Marshal(dict); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	dict.Remove("c")
+
+	data, err := json.Marshal(dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := collection.DictionarySyncEmpty[string, int]()
+	if err := json.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, result.Size())
+	}
+
+	if value, _ := result.Get("a"); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+}
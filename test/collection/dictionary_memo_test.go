@@ -0,0 +1,65 @@
+package collection
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestMemoDictionaryComputesOncePerKey(t *testing.T) {
+	var calls [10]atomic.Int32
+
+	memo := collection.Memoize(func(n int) int {
+		calls[n].Add(1)
+		return n * n
+	})
+
+	var wg sync.WaitGroup
+	n := 1000
+
+	wg.Add(n)
+
+	for i := range n {
+		go func(key int) {
+			defer wg.Done()
+			if value := memo.Get(key); value != key*key {
+				t.Errorf("Expected %d but got %d", key*key, value)
+			}
+		}(i % 10)
+	}
+
+	wg.Wait()
+
+	for key := range calls {
+		if result := calls[key].Load(); result != 1 {
+			t.Errorf("Expected %d but got %d", 1, result)
+		}
+	}
+
+	if memo.Size() != 10 {
+		t.Errorf("Expected %d but got %d", 10, memo.Size())
+	}
+}
+
+func TestMemoDictionaryRemove(t *testing.T) {
+	calls := 0
+	memo := collection.Memoize(func(s string) int {
+		calls++
+		return len(s)
+	})
+
+	memo.Get("abc")
+	memo.Get("abc")
+
+	if !memo.Remove("abc") {
+		t.Error("Expected cached key to be removed")
+	}
+
+	memo.Get("abc")
+
+	if calls != 2 {
+		t.Errorf("Expected %d but got %d", 2, calls)
+	}
+}
@@ -0,0 +1,138 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+type putEvent struct {
+	key    string
+	old    int
+	exists bool
+	value  int
+}
+
+type removeEvent struct {
+	key string
+	old int
+}
+
+func TestObservableDictionaryCallbacks(t *testing.T) {
+	dict := collection.ObservableDictionaryFrom(collection.MakeDictionary(map[string]int{}))
+
+	puts := []putEvent{}
+	removes := []removeEvent{}
+
+	dict.OnPut(func(key string, old int, exists bool, value int) {
+		puts = append(puts, putEvent{key, old, exists, value})
+	}).OnRemove(func(key string, old int) {
+		removes = append(removes, removeEvent{key, old})
+	})
+
+	dict.Put("a", 1)
+	dict.Put("a", 2)
+	dict.Remove("a")
+	dict.Remove("missing")
+
+	expectedPuts := []putEvent{
+		{"a", 0, false, 1},
+		{"a", 1, true, 2},
+	}
+
+	if len(puts) != len(expectedPuts) {
+		t.Fatalf("Expected %d but got %d", len(expectedPuts), len(puts))
+	}
+
+	for i, expected := range expectedPuts {
+		if puts[i] != expected {
+			t.Errorf("Expected %v but got %v", expected, puts[i])
+		}
+	}
+
+	if len(removes) != 1 || removes[0] != (removeEvent{"a", 2}) {
+		t.Errorf("Expected %v but got %v", []removeEvent{{"a", 2}}, removes)
+	}
+}
+
+func TestObservableDictionarySyncCallbackReentry(t *testing.T) {
+	dict := collection.ObservableDictionaryFrom(collection.MakeDictionarySync(map[string]int{}))
+
+	sizes := []int{}
+	dict.OnPut(func(key string, old int, exists bool, value int) {
+		sizes = append(sizes, dict.Size())
+	})
+
+	dict.PutIfAbsent("a", 1)
+	dict.PutIfAbsent("a", 2)
+
+	if len(sizes) != 1 || sizes[0] != 1 {
+		t.Errorf("Expected %v but got %v", []int{1}, sizes)
+	}
+}
+
+func TestObservableDictionaryGetOrPut(t *testing.T) {
+	dict := collection.ObservableDictionaryFrom(collection.MakeDictionary(map[string]int{}))
+
+	puts := []putEvent{}
+	dict.OnPut(func(key string, old int, exists bool, value int) {
+		puts = append(puts, putEvent{key, old, exists, value})
+	})
+
+	dict.GetOrPut("a", 1)
+	if value := dict.GetOrPut("a", 2); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if len(puts) != 1 || puts[0] != (putEvent{"a", 0, false, 1}) {
+		t.Errorf("Expected %v but got %v", []putEvent{{"a", 0, false, 1}}, puts)
+	}
+}
+
+func TestObservableDictionaryComputeIfAbsent(t *testing.T) {
+	dict := collection.ObservableDictionaryFrom(collection.MakeDictionarySync(map[string]int{}))
+
+	puts := []putEvent{}
+	dict.OnPut(func(key string, old int, exists bool, value int) {
+		puts = append(puts, putEvent{key, old, exists, value})
+	})
+
+	factory := func(k string) int { return len(k) }
+	dict.ComputeIfAbsent("abc", factory)
+	dict.ComputeIfAbsent("abc", factory)
+
+	if len(puts) != 1 || puts[0] != (putEvent{"abc", 0, false, 3}) {
+		t.Errorf("Expected %v but got %v", []putEvent{{"abc", 0, false, 3}}, puts)
+	}
+}
+
+func TestObservableDictionaryCompute(t *testing.T) {
+	dict := collection.ObservableDictionaryFrom(collection.MakeDictionary(map[string]int{}))
+
+	puts := []putEvent{}
+	dict.OnPut(func(key string, old int, exists bool, value int) {
+		puts = append(puts, putEvent{key, old, exists, value})
+	})
+
+	removes := []removeEvent{}
+	dict.OnRemove(func(key string, old int) {
+		removes = append(removes, removeEvent{key, old})
+	})
+
+	increment := func(k string, old int, exists bool) (int, bool) { return old + 1, true }
+	drop := func(k string, old int, exists bool) (int, bool) { return 0, false }
+
+	dict.Compute("hits", increment)
+	dict.Compute("hits", increment)
+	dict.Compute("hits", drop)
+	dict.Compute("hits", drop)
+
+	expectedPuts := []putEvent{{"hits", 0, false, 1}, {"hits", 1, true, 2}}
+	if len(puts) != 2 || puts[0] != expectedPuts[0] || puts[1] != expectedPuts[1] {
+		t.Errorf("Expected %v but got %v", expectedPuts, puts)
+	}
+
+	if len(removes) != 1 || removes[0] != (removeEvent{"hits", 2}) {
+		t.Errorf("Expected %v but got %v", []removeEvent{{"hits", 2}}, removes)
+	}
+}
@@ -0,0 +1,105 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestDictionaryOrderedMixedOperations(t *testing.T) {
+	dict := collection.DictionaryOrderedEmpty[string, int]()
+
+	dict.Put("c", 3)
+	dict.Put("a", 1)
+	dict.Put("d", 4)
+	dict.Put("b", 2)
+	dict.Put("a", 10)
+	dict.Remove("d")
+	dict.PutIfAbsent("e", 5)
+	dict.Remove("c")
+	dict.Put("c", 30)
+
+	expectedKeys := []string{"a", "b", "e", "c"}
+	if keys := dict.Keys(); !slices.Equal(keys, expectedKeys) {
+		t.Errorf("Expected %v but got %v", expectedKeys, keys)
+	}
+
+	expectedValues := []int{10, 2, 5, 30}
+	if values := dict.Values(); !slices.Equal(values, expectedValues) {
+		t.Errorf("Expected %v but got %v", expectedValues, values)
+	}
+
+	visited := []string{}
+	dict.ForEach(func(k string, _ int) {
+		visited = append(visited, k)
+	})
+	if !slices.Equal(visited, expectedKeys) {
+		t.Errorf("Expected %v but got %v", expectedKeys, visited)
+	}
+
+	pairs := dict.Pairs()
+	for i, pair := range pairs {
+		if pair.Key() != expectedKeys[i] || pair.Value() != expectedValues[i] {
+			t.Errorf("Expected (%s, %d) but got (%s, %d)", expectedKeys[i], expectedValues[i], pair.Key(), pair.Value())
+		}
+	}
+}
+
+func TestDictionaryOrderedFilterAndPop(t *testing.T) {
+	dict := collection.DictionaryOrderedFromPairs([]collection.Pair[string, int]{
+		collection.NewPair("e", 5),
+		collection.NewPair("b", 2),
+		collection.NewPair("d", 4),
+		collection.NewPair("a", 1),
+	})
+
+	filtered := dict.Filter(func(_ string, v int) bool { return v > 1 })
+	if keys := filtered.Keys(); !slices.Equal(keys, []string{"e", "b", "d"}) {
+		t.Errorf("Expected %v but got %v", []string{"e", "b", "d"}, keys)
+	}
+
+	dict.FilterSelf(func(_ string, v int) bool { return v%2 == 0 })
+	if keys := dict.Keys(); !slices.Equal(keys, []string{"b", "d"}) || dict.Size() != 2 {
+		t.Errorf("Expected %v but got %v", []string{"b", "d"}, keys)
+	}
+
+	pair, ok := dict.PopItem()
+	if !ok || pair.Key() != "d" || pair.Value() != 4 {
+		t.Errorf("Expected (%s, %d) but got (%s, %d)", "d", 4, pair.Key(), pair.Value())
+	}
+
+	cloned := dict.Clone()
+	dict.Put("z", 26)
+	if keys := cloned.Keys(); !slices.Equal(keys, []string{"b"}) {
+		t.Errorf("Expected %v but got %v", []string{"b"}, keys)
+	}
+
+	if _, ok := dict.Compute("b", func(string, int, bool) (int, bool) { return 0, false }); ok {
+		t.Error("Expected key b to be removed")
+	}
+
+	if keys := dict.Keys(); !slices.Equal(keys, []string{"z"}) {
+		t.Errorf("Expected %v but got %v", []string{"z"}, keys)
+	}
+}
+
+func TestDictionaryOrderedAll(t *testing.T) {
+	dict := collection.DictionaryOrderedFromPairs([]collection.Pair[string, int]{
+		collection.NewPair("c", 3),
+		collection.NewPair("a", 1),
+		collection.NewPair("b", 2),
+	})
+
+	keys := []string{}
+	for k := range dict.All() {
+		keys = append(keys, k)
+		if k == "a" {
+			break
+		}
+	}
+
+	if !slices.Equal(keys, []string{"c", "a"}) {
+		t.Errorf("Expected %v but got %v", []string{"c", "a"}, keys)
+	}
+}
@@ -3,7 +3,9 @@ package collection
 import (
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/Rafael24595/go-collections/collection"
 )
@@ -36,3 +38,274 @@ func TestDictionarySyncStress(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestDictionarySyncCountByValue(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 1})
+
+	counts := collection.DictionaryCountByValue(dict)
+
+	if result, ok := counts.Get(1); !ok || result != 2 {
+		t.Errorf("Expected %d but got %d", 2, result)
+	}
+
+	if result, ok := counts.Get(2); !ok || result != 1 {
+		t.Errorf("Expected %d but got %d", 1, result)
+	}
+}
+
+func TestDictionarySyncVectorFoldInto(t *testing.T) {
+	counts := collection.DictionarySyncFromMap(map[string]int{"a": 100})
+
+	var wg sync.WaitGroup
+	n := 100
+
+	wg.Add(n)
+
+	for range n {
+		go func() {
+			defer wg.Done()
+			vec := collection.VectorFromList([]string{"a", "b"})
+			collection.VectorFoldInto(vec, counts, func(s string) string {
+				return s
+			}, func(existing int, exists bool, item string) int {
+				return existing + 1
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if result, _ := counts.Get("a"); result != 100+n {
+		t.Errorf("Expected %d but got %d", 100+n, result)
+	}
+
+	if result, _ := counts.Get("b"); result != n {
+		t.Errorf("Expected %d but got %d", n, result)
+	}
+}
+
+func TestDictionarySyncGetReturnsCopy(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string][2]int{"a": {1, 2}})
+
+	value, ok := dict.Get("a")
+	if !ok || value != [2]int{1, 2} {
+		t.Errorf("Expected %v but got %v", [2]int{1, 2}, value)
+	}
+
+	value[0] = 9
+
+	if stored, _ := dict.Get("a"); stored != [2]int{1, 2} {
+		t.Errorf("Expected %v but got %v", [2]int{1, 2}, stored)
+	}
+
+	if value, ok := dict.Get("b"); ok || value != [2]int{} {
+		t.Errorf("Expected %v but got %v", [2]int{}, value)
+	}
+}
+
+func TestDictionarySyncFindPair(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	pair, ok := dict.FindPair(func(k string, v int) bool {
+		return k == "c"
+	})
+
+	if !ok || pair.Key() != "c" || pair.Value() != 3 {
+		t.Errorf("Expected (%s, %d) but got (%s, %d)", "c", 3, pair.Key(), pair.Value())
+	}
+}
+
+func TestDictionarySyncGetOrDefault(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1})
+
+	if value := dict.GetOrDefault("b", 9); value != 9 {
+		t.Errorf("Expected %d but got %d", 9, value)
+	}
+
+	if dict.Size() != 1 || dict.Exists("b") {
+		t.Errorf("Expected %d but got %d", 1, dict.Size())
+	}
+}
+
+func TestDictionarySyncGetOrPut(t *testing.T) {
+	dict := collection.DictionarySyncEmpty[string, int]()
+
+	var wg sync.WaitGroup
+	results := make([]int, 100)
+	for i := range results {
+		wg.Go(func() {
+			results[i] = dict.GetOrPut("key", i)
+		})
+	}
+	wg.Wait()
+
+	stored, _ := dict.Get("key")
+	for _, result := range results {
+		if result != stored {
+			t.Errorf("Expected %d but got %d", stored, result)
+		}
+	}
+
+	if value := dict.GetOrPut("key", -1); value != stored {
+		t.Errorf("Expected %d but got %d", stored, value)
+	}
+
+	if dict.Size() != 1 {
+		t.Errorf("Expected %d but got %d", 1, dict.Size())
+	}
+}
+
+func TestDictionarySyncComputeIfAbsent(t *testing.T) {
+	dict := collection.DictionarySyncEmpty[int, int]()
+
+	keys := 10
+	calls := make([]int, keys)
+	var computed atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := range 500 {
+		wg.Go(func() {
+			key := i % keys
+			value, ok := dict.ComputeIfAbsent(key, func(k int) int {
+				calls[k]++
+				return k * k
+			})
+			if value != key*key {
+				t.Errorf("Expected %d but got %d", key*key, value)
+			}
+			if ok {
+				computed.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+
+	if computed.Load() != int32(keys) {
+		t.Errorf("Expected %d computations but got %d", keys, computed.Load())
+	}
+
+	for key, count := range calls {
+		if count != 1 {
+			t.Errorf("Expected factory of key %d to run %d time but ran %d", key, 1, count)
+		}
+	}
+
+	if dict.Size() != keys {
+		t.Errorf("Expected %d but got %d", keys, dict.Size())
+	}
+}
+
+func TestDictionarySyncPopItem(t *testing.T) {
+	n := 1000
+	dict := collection.DictionarySyncEmpty[int, int]()
+	for i := range n {
+		dict.Put(i, i)
+	}
+
+	var mu sync.Mutex
+	popped := map[int]int{}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for pair, ok := dict.PopItem(); ok; pair, ok = dict.PopItem() {
+				mu.Lock()
+				popped[pair.Key()]++
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	if dict.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, dict.Size())
+	}
+
+	if len(popped) != n {
+		t.Errorf("Expected %d but got %d", n, len(popped))
+	}
+
+	for key, count := range popped {
+		if count != 1 {
+			t.Errorf("Expected key %d to be popped %d time but got %d", key, 1, count)
+		}
+	}
+}
+
+func TestDictionarySyncCleanWith(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	released := map[string]int{}
+	dict.CleanWith(func(k string, v int) {
+		released[k] = v
+		if size := dict.Size(); size != 0 {
+			t.Errorf("Expected %d but got %d", 0, size)
+		}
+	})
+
+	if dict.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, dict.Size())
+	}
+
+	if len(released) != 3 || released["a"] != 1 || released["b"] != 2 || released["c"] != 3 {
+		t.Errorf("Expected %d released entries but got %d", 3, len(released))
+	}
+}
+
+func TestDictionarySyncComputeConcurrent(t *testing.T) {
+	dict := collection.DictionarySyncEmpty[string, int]()
+
+	const (
+		workers    = 16
+		increments = 1000
+	)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for range increments {
+				dict.Compute("counter", func(_ string, old int, _ bool) (int, bool) {
+					return old + 1, true
+				})
+			}
+		})
+	}
+	wg.Wait()
+
+	if total, _ := dict.Get("counter"); total != workers*increments {
+		t.Errorf("Expected %d but got %d", workers*increments, total)
+	}
+}
+
+func TestDictionarySyncAllReleasesLock(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	total := 0
+	for _, v := range dict.All() {
+		total += v
+	}
+
+	if total != 6 {
+		t.Errorf("Expected %d but got %d", 6, total)
+	}
+
+	for range dict.All() {
+		break
+	}
+
+	done := make(chan struct{})
+	go func() {
+		dict.Put("d", 4)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the read lock to be released after breaking the loop")
+	}
+
+	if dict.Size() != 4 {
+		t.Errorf("Expected %d but got %d", 4, dict.Size())
+	}
+}
@@ -2,6 +2,9 @@ package collection
 
 import (
 	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/Rafael24595/go-collections/collection"
@@ -128,3 +131,850 @@ func TestDictionaryMinWithPredicate(t *testing.T) {
 		t.Errorf("expected (%s, %s, %d), got (%s, %s, %d)", expected_key, expected_val.name, expected_val.score, pair.Key(), pair.Value().name, value)
 	}
 }
+
+type dictionaryCleaner interface {
+	Clean() collection.IDictionary[string, int]
+}
+
+func TestDictionaryFreeze(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+
+	frozen := dict.Freeze()
+
+	if _, ok := any(frozen).(interface{ Put(string, int) (int, bool) }); ok {
+		t.Error("Expected frozen dictionary to not expose Put")
+	}
+
+	if _, ok := any(frozen).(interface{ Remove(string) (int, bool) }); ok {
+		t.Error("Expected frozen dictionary to not expose Remove")
+	}
+
+	if _, ok := any(frozen).(dictionaryCleaner); ok {
+		t.Error("Expected frozen dictionary to not expose Clean")
+	}
+
+	if value, ok := frozen.Get("a"); !ok || value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if frozen.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, frozen.Size())
+	}
+
+	if !frozen.Exists("b") || frozen.Exists("c") {
+		t.Error("Expected frozen dictionary to contain only keys a and b")
+	}
+
+	dict.Put("c", 3)
+
+	if value, ok := frozen.Get("c"); !ok || value != 3 {
+		t.Errorf("Expected %d but got %d", 3, value)
+	}
+}
+
+func TestDictionaryCountByValue(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]string{
+		"a": "red",
+		"b": "blue",
+		"c": "red",
+		"d": "red",
+		"e": "blue",
+		"f": "green",
+	})
+
+	counts := collection.DictionaryCountByValue(dict)
+
+	expected := map[string]int{"red": 3, "blue": 2, "green": 1}
+	if counts.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), counts.Size())
+	}
+
+	for value, count := range expected {
+		if result, ok := counts.Get(value); !ok || result != count {
+			t.Errorf("Expected %d but got %d", count, result)
+		}
+	}
+}
+
+func TestDictionaryEqualKeys(t *testing.T) {
+	a := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+	b := collection.DictionarySyncFromMap(map[string]int{"a": 3, "b": 4})
+
+	if !collection.DictionaryEqualKeys[string, int](a, b) {
+		t.Error("Expected dictionaries with the same keys to be equal")
+	}
+
+	c := collection.DictionaryFromMap(map[string]int{"a": 1, "c": 2})
+	if collection.DictionaryEqualKeys[string, int](a, c) {
+		t.Error("Expected dictionaries with different keys to not be equal")
+	}
+
+	d := collection.DictionaryFromMap(map[string]int{"a": 1})
+	if collection.DictionaryEqualKeys[string, int](a, d) {
+		t.Error("Expected dictionaries with different sizes to not be equal")
+	}
+}
+
+func TestDictionaryMergeVectors(t *testing.T) {
+	a := collection.DictionaryFromMap(map[string]*collection.Vector[int]{
+		"x": collection.VectorFromList([]int{1, 2}),
+		"y": collection.VectorFromList([]int{3}),
+	})
+	b := collection.DictionaryFromMap(map[string]*collection.Vector[int]{
+		"x": collection.VectorFromList([]int{4}),
+		"z": collection.VectorFromList([]int{5}),
+	})
+
+	merged := collection.DictionaryMergeVectors(a, b)
+
+	expected := map[string][]int{"x": {1, 2, 4}, "y": {3}, "z": {5}}
+	if merged.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), merged.Size())
+	}
+
+	for key, items := range expected {
+		vector, ok := merged.Get(key)
+		if !ok || vector.Size() != len(items) {
+			t.Fatalf("Expected %v but got %v", items, vector)
+		}
+		for i, item := range items {
+			if result, _ := vector.Get(i); result != item {
+				t.Errorf("Expected %d but got %d", item, result)
+			}
+		}
+	}
+
+	if vector, _ := a.Get("x"); vector.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, vector.Size())
+	}
+
+	if vector, _ := b.Get("x"); vector.Size() != 1 {
+		t.Errorf("Expected %d but got %d", 1, vector.Size())
+	}
+}
+
+func TestDictionarySortedByValue(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"go": 90, "rust": 85, "zig": 92, "c": 85})
+
+	ranking := collection.DictionarySortedByValue(dict, func(a, b int) bool {
+		return a > b
+	})
+
+	expected := []int{92, 90, 85, 85}
+	if ranking.Size() != len(expected) {
+		t.Fatalf("Expected %d but got %d", len(expected), ranking.Size())
+	}
+
+	for i, value := range expected {
+		if pair, _ := ranking.Get(i); pair.Value() != value {
+			t.Errorf("Expected %d but got %d", value, pair.Value())
+		}
+	}
+
+	first, _ := ranking.First()
+	if first.Key() != "zig" {
+		t.Errorf("Expected %s but got %s", "zig", first.Key())
+	}
+}
+
+func TestDictionaryFindPair(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	pair, ok := dict.FindPair(func(k string, v int) bool {
+		return v == 2
+	})
+
+	if !ok || pair.Key() != "b" || pair.Value() != 2 {
+		t.Errorf("Expected (%s, %d) but got (%s, %d)", "b", 2, pair.Key(), pair.Value())
+	}
+
+	_, ok = dict.FindPair(func(k string, v int) bool {
+		return v == 4
+	})
+
+	if ok {
+		t.Error("Expected no pair to match")
+	}
+}
+
+func TestDictionaryGetAs(t *testing.T) {
+	config := collection.DictionaryFromMap(map[string]any{"port": 8080, "host": "localhost"})
+
+	if port, ok := collection.DictionaryGetAs[int](config, "port"); !ok || port != 8080 {
+		t.Errorf("Expected %d but got %d", 8080, port)
+	}
+
+	if host, ok := collection.DictionaryGetAs[int](config, "host"); ok || host != 0 {
+		t.Errorf("Expected %d but got %d", 0, host)
+	}
+
+	if missing, ok := collection.DictionaryGetAs[string](config, "user"); ok || missing != "" {
+		t.Errorf("Expected empty string but got %s", missing)
+	}
+}
+
+func TestDictionaryRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	empty := collection.DictionaryEmpty[string, int]()
+	if _, ok := collection.DictionaryRandom[string, int](empty, r); ok {
+		t.Error("Expected ok == false for empty dictionary")
+	}
+
+	single := collection.DictionaryFromMap(map[string]int{"a": 1})
+	for range 10 {
+		if pair, ok := collection.DictionaryRandom[string, int](single, r); !ok || pair.Key() != "a" || pair.Value() != 1 {
+			t.Errorf("Expected (%s, %d) but got (%s, %d)", "a", 1, pair.Key(), pair.Value())
+		}
+	}
+
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	seen := map[string]int{}
+	for range 300 {
+		pair, ok := collection.DictionaryRandom[string, int](dict, r)
+		if !ok {
+			t.Fatal("expected ok == true")
+		}
+		if value, _ := dict.Get(pair.Key()); value != pair.Value() {
+			t.Errorf("Expected %d but got %d", value, pair.Value())
+		}
+		seen[pair.Key()]++
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("Expected %d but got %d", 3, len(seen))
+	}
+}
+
+func TestDictionaryRandomDeterministic(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5})
+
+	sample := func() []string {
+		r := rand.New(rand.NewPCG(7, 11))
+		keys := make([]string, 0, 20)
+		for range 20 {
+			pair, _ := collection.DictionaryRandom[string, int](dict, r)
+			keys = append(keys, pair.Key())
+		}
+		return keys
+	}
+
+	expected := []string{
+		"b", "b", "c", "a", "b", "b", "d", "b", "d", "b",
+		"a", "d", "b", "e", "c", "d", "d", "e", "c", "b",
+	}
+
+	for range 5 {
+		if keys := sample(); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v but got %v", expected, keys)
+		}
+	}
+}
+
+func TestDictionaryToVector(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	lines := collection.DictionaryToVector(dict, func(k string, v int) string {
+		return fmt.Sprintf("%s=%d", k, v)
+	})
+
+	if lines.Size() != dict.Size() {
+		t.Errorf("Expected %d but got %d", dict.Size(), lines.Size())
+	}
+
+	for _, expected := range []string{"a=1", "b=2", "c=3"} {
+		if !lines.Contains(func(s string) bool { return s == expected }) {
+			t.Errorf("Expected %v to contain %s", lines.Collect(), expected)
+		}
+	}
+}
+
+func TestDictionaryGetOrDefault(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1})
+
+	if value := dict.GetOrDefault("a", 9); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if value := dict.GetOrDefault("b", 9); value != 9 {
+		t.Errorf("Expected %d but got %d", 9, value)
+	}
+
+	if dict.Size() != 1 || dict.Exists("b") {
+		t.Errorf("Expected %d but got %d", 1, dict.Size())
+	}
+}
+
+func TestDictionaryCollectReturnsCopy(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2})
+
+	collected := dict.Collect()
+	collected["a"] = 9
+	collected["c"] = 3
+	delete(collected, "b")
+
+	if value, _ := dict.Get("a"); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if dict.Size() != 2 || !dict.Exists("b") || dict.Exists("c") {
+		t.Errorf("Expected %d but got %d", 2, dict.Size())
+	}
+}
+
+func TestDictionaryMapEntries(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	result := collection.DictionaryMapEntries(dict, func(k string, v int) (int, string) {
+		return v * 10, k + k
+	})
+
+	expected := map[int]string{10: "aa", 20: "bb", 30: "cc"}
+	if result.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), result.Size())
+	}
+
+	for k, v := range expected {
+		if value, _ := result.Get(k); value != v {
+			t.Errorf("Expected %s but got %s", v, value)
+		}
+	}
+
+	if dict.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, dict.Size())
+	}
+}
+
+func TestDictionaryMapEntriesKeyCollision(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	result := collection.DictionaryMapEntries(dict, func(k string, v int) (bool, string) {
+		return v%2 == 0, k
+	})
+
+	if result.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, result.Size())
+	}
+
+	if value, _ := result.Get(true); value != "b" {
+		t.Errorf("Expected %s but got %s", "b", value)
+	}
+
+	if value, _ := result.Get(false); value != "a" && value != "c" {
+		t.Errorf("Expected %s or %s but got %s", "a", "c", value)
+	}
+}
+
+func TestDictionaryGetOrPut(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1})
+
+	if value := dict.GetOrPut("a", 9); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if value, _ := dict.Get("a"); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	if value := dict.GetOrPut("b", 2); value != 2 {
+		t.Errorf("Expected %d but got %d", 2, value)
+	}
+
+	if value, exists := dict.Get("b"); !exists || value != 2 {
+		t.Errorf("Expected %d but got %d", 2, value)
+	}
+
+	if dict.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, dict.Size())
+	}
+}
+
+func TestDictionaryComputeIfAbsent(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1})
+
+	calls := 0
+	factory := func(k string) int {
+		calls++
+		return len(k)
+	}
+
+	if value, computed := dict.ComputeIfAbsent("a", factory); value != 1 || computed {
+		t.Errorf("Expected %d without computing but got %d, computed %t", 1, value, computed)
+	}
+
+	if value, computed := dict.ComputeIfAbsent("abc", factory); value != 3 || !computed {
+		t.Errorf("Expected %d computed but got %d, computed %t", 3, value, computed)
+	}
+
+	if value, computed := dict.ComputeIfAbsent("abc", factory); value != 3 || computed {
+		t.Errorf("Expected %d without computing but got %d, computed %t", 3, value, computed)
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected %d but got %d", 1, calls)
+	}
+
+	if dict.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, dict.Size())
+	}
+}
+
+func TestDictionaryPopItem(t *testing.T) {
+	source := map[string]int{"a": 1, "b": 2, "c": 3}
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	popped := map[string]int{}
+	for pair, ok := dict.PopItem(); ok; pair, ok = dict.PopItem() {
+		popped[pair.Key()] = pair.Value()
+	}
+
+	if dict.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, dict.Size())
+	}
+
+	if len(popped) != len(source) {
+		t.Errorf("Expected %d but got %d", len(source), len(popped))
+	}
+
+	for k, v := range source {
+		if popped[k] != v {
+			t.Errorf("Expected %d but got %d", v, popped[k])
+		}
+	}
+
+	if _, ok := dict.PopItem(); ok {
+		t.Errorf("Expected PopItem on an empty dictionary to fail")
+	}
+}
+
+func TestVectorChunkDictionary(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6, 7})
+
+	chunks := collection.VectorChunkDictionary(vec, 3)
+	if chunks.Size() != 3 {
+		t.Fatalf("Expected %d but got %d", 3, chunks.Size())
+	}
+
+	expected := map[int][]int{0: {1, 2, 3}, 1: {4, 5, 6}, 2: {7}}
+	for index, items := range expected {
+		chunk, exists := chunks.Get(index)
+		if !exists {
+			t.Fatalf("Expected chunk %d to exist", index)
+		}
+		assertVectorEquals(t, chunk, items)
+	}
+
+	for _, size := range []int{0, -1} {
+		if empty := collection.VectorChunkDictionary(vec, size); empty == nil || empty.Size() != 0 {
+			t.Errorf("Expected an empty dictionary for a non positive size %d", size)
+		}
+	}
+
+	if empty := collection.VectorChunkDictionary(collection.VectorEmpty[int](), 2); empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+}
+
+func TestVectorGroupBy(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6, 7})
+
+	groups := collection.VectorGroupBy(vec, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	if groups.Size() != 2 {
+		t.Fatalf("Expected %d but got %d", 2, groups.Size())
+	}
+
+	even, _ := groups.Get("even")
+	assertVectorEquals(t, even, []int{2, 4, 6})
+
+	odd, _ := groups.Get("odd")
+	assertVectorEquals(t, odd, []int{1, 3, 5, 7})
+}
+
+func TestListGroupBy(t *testing.T) {
+	words := []string{"go", "zig", "c", "rust", "odin", "d"}
+
+	groups := collection.ListGroupBy(words, func(w string) int {
+		return len(w)
+	}, collection.MakeDictionarySync[int, *collection.Vector[string]])
+
+	if _, ok := groups.(*collection.DictionarySync[int, *collection.Vector[string]]); !ok {
+		t.Errorf("Expected a DictionarySync but got %T", groups)
+	}
+
+	expected := map[int][]string{1: {"c", "d"}, 2: {"go"}, 3: {"zig"}, 4: {"rust", "odin"}}
+	if groups.Size() != len(expected) {
+		t.Fatalf("Expected %d but got %d", len(expected), groups.Size())
+	}
+
+	for length, group := range expected {
+		vec, _ := groups.Get(length)
+		assertVectorEquals(t, vec, group)
+	}
+}
+
+func TestDictionaryMinMaxByValue(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	dict := collection.DictionaryFromMap(map[string]int{"a": 3, "b": 1, "c": 7, "d": 5})
+	minPair, maxPair, ok := collection.DictionaryMinMaxByValue(dict, less)
+	if !ok {
+		t.Fatalf("Expected a non-empty dictionary")
+	}
+
+	if minPair.Key() != "b" || minPair.Value() != 1 {
+		t.Errorf("Expected %s but got %s", "b", minPair.Key())
+	}
+
+	if maxPair.Key() != "c" || maxPair.Value() != 7 {
+		t.Errorf("Expected %s but got %s", "c", maxPair.Key())
+	}
+
+	single := collection.DictionarySyncFromMap(map[string]int{"only": 4})
+	minPair, maxPair, ok = collection.DictionaryMinMaxByValue(single, less)
+	if !ok || minPair != maxPair || minPair.Key() != "only" {
+		t.Errorf("Expected min and max to be %s but got %s and %s", "only", minPair.Key(), maxPair.Key())
+	}
+
+	if _, _, ok := collection.DictionaryMinMaxByValue(collection.DictionaryEmpty[string, int](), less); ok {
+		t.Errorf("Expected an empty dictionary to be rejected")
+	}
+}
+
+func TestDictionaryProject(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	projected := collection.DictionaryProject(dict,
+		func(k string, v int) bool { return v > 1 },
+		func(k string, v int) string { return fmt.Sprintf("%s%d", k, v) },
+	)
+
+	expected := map[string]string{"b": "b2", "c": "c3"}
+	if projected.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), projected.Size())
+	}
+
+	for k, v := range expected {
+		if value, _ := projected.Get(k); value != v {
+			t.Errorf("Expected %s but got %s", v, value)
+		}
+	}
+
+	if projected.Exists("a") {
+		t.Errorf("Expected key %s to be filtered out", "a")
+	}
+}
+
+func TestDictionaryForEachSorted(t *testing.T) {
+	dict := collection.DictionarySyncFromMap(map[string]int{"d": 4, "b": 2, "a": 1, "e": 5, "c": 3})
+
+	for range 10 {
+		keys := []string{}
+		values := []int{}
+		collection.DictionaryForEachSorted(dict, func(k string, v int) {
+			keys = append(keys, k)
+			values = append(values, v)
+		})
+
+		assertVectorEquals(t, collection.VectorFromList(keys), []string{"a", "b", "c", "d", "e"})
+		assertVectorEquals(t, collection.VectorFromList(values), []int{1, 2, 3, 4, 5})
+	}
+}
+
+func TestDictionaryCountByBucket(t *testing.T) {
+	ages := collection.DictionarySyncFromMap(map[string]int{
+		"ann": 17, "bob": 34, "eve": 29, "joe": 21, "kim": 38, "ted": 65,
+	})
+
+	counts := collection.DictionaryCountByBucket(ages, func(age int) string {
+		switch {
+		case age < 18:
+			return "minor"
+		case age < 30:
+			return "young"
+		case age < 65:
+			return "adult"
+		}
+		return "senior"
+	})
+
+	expected := map[string]int{"minor": 1, "young": 2, "adult": 2, "senior": 1}
+	total := 0
+	for bucket, count := range expected {
+		if value, _ := counts.Get(bucket); value != count {
+			t.Errorf("Expected %d but got %d", count, value)
+		}
+		total += count
+	}
+
+	if counts.Size() != len(expected) || total != ages.Size() {
+		t.Errorf("Expected %d but got %d", ages.Size(), total)
+	}
+}
+
+func TestDictionaryDiff(t *testing.T) {
+	older := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	newer := collection.DictionarySyncFromMap(map[string]int{"b": 2, "c": 4, "d": 5})
+
+	added, removed, changed := collection.DictionaryDiff(older, newer)
+
+	if len(added) != 1 || added["d"] != 5 {
+		t.Errorf("Expected %d but got %d", 5, added["d"])
+	}
+
+	if len(removed) != 1 || removed["a"] != 1 {
+		t.Errorf("Expected %d but got %d", 1, removed["a"])
+	}
+
+	pair, ok := changed["c"]
+	if len(changed) != 1 || !ok {
+		t.Errorf("Expected %d but got %d", 1, len(changed))
+	}
+
+	if pair.Key() != 3 || pair.Value() != 4 {
+		t.Errorf("Expected %d -> %d but got %d -> %d", 3, 4, pair.Key(), pair.Value())
+	}
+}
+
+func TestDictionaryCleanWith(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	total := 0
+	dict.CleanWith(func(_ string, v int) {
+		total += v
+	})
+
+	if total != 6 {
+		t.Errorf("Expected %d but got %d", 6, total)
+	}
+
+	if dict.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, dict.Size())
+	}
+}
+
+func TestDictionaryInvert(t *testing.T) {
+	emails := collection.DictionaryFromMap(map[int]string{1: "ann@mail.com", 2: "bob@mail.com"})
+
+	users := collection.DictionaryInvert(emails)
+
+	if users.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, users.Size())
+	}
+
+	if id, _ := users.Get("bob@mail.com"); id != 2 {
+		t.Errorf("Expected %d but got %d", 2, id)
+	}
+
+	roles := collection.DictionarySyncFromMap(map[string]string{"ann": "admin", "bob": "user", "eve": "admin"})
+
+	inverted := collection.DictionaryInvert(roles)
+	if inverted.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, inverted.Size())
+	}
+
+	if admin, _ := inverted.Get("admin"); admin != "ann" && admin != "eve" {
+		t.Errorf("Expected ann or eve but got %s", admin)
+	}
+}
+
+func TestDictionaryInvertMulti(t *testing.T) {
+	roles := collection.DictionaryFromMap(map[string]string{"ann": "admin", "bob": "user", "eve": "admin"})
+
+	members := collection.DictionaryInvertMulti(roles)
+
+	if members.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, members.Size())
+	}
+
+	admins, _ := members.Get("admin")
+	assertVectorEquals(t, admins.Sort(func(a, b string) bool { return a < b }), []string{"ann", "eve"})
+
+	users, _ := members.Get("user")
+	assertVectorEquals(t, users, []string{"bob"})
+}
+
+func TestVectorCountByKey(t *testing.T) {
+	lines := collection.VectorFromList([]string{
+		"INFO start", "WARN slow", "INFO ready", "ERROR failed", "INFO done", "WARN retry",
+	})
+
+	counts := collection.VectorCountByKey(lines, func(l string) string {
+		return strings.Fields(l)[0]
+	})
+
+	expected := map[string]int{"INFO": 3, "WARN": 2, "ERROR": 1}
+	if counts.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), counts.Size())
+	}
+
+	total := 0
+	for level, count := range expected {
+		if value, _ := counts.Get(level); value != count {
+			t.Errorf("Expected %d but got %d", count, value)
+		}
+		total += count
+	}
+
+	if total != lines.Size() {
+		t.Errorf("Expected %d but got %d", lines.Size(), total)
+	}
+}
+
+func TestDictionaryCompute(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1})
+
+	value, ok := dict.Compute("a", func(_ string, old int, exists bool) (int, bool) {
+		if !exists {
+			t.Error("Expected key a to exist")
+		}
+		return old * 10, true
+	})
+	if !ok || value != 10 {
+		t.Errorf("Expected %d but got %d", 10, value)
+	}
+
+	value, ok = dict.Compute("b", func(_ string, old int, exists bool) (int, bool) {
+		return 0, false
+	})
+	if ok || value != 0 || dict.Exists("b") {
+		t.Errorf("Expected %d but got %d", 0, value)
+	}
+
+	if _, ok := dict.Compute("a", func(string, int, bool) (int, bool) { return 0, false }); ok || dict.Exists("a") {
+		t.Error("Expected key a to be removed")
+	}
+}
+
+func TestDictionaryDistinctValues(t *testing.T) {
+	roles := collection.DictionarySyncFromMap(map[string]string{
+		"ann": "admin", "bob": "user", "eve": "admin", "joe": "guest", "kim": "user",
+	})
+
+	distinct := collection.DictionaryDistinctValues(roles)
+	sorted := distinct.Sort(func(a, b string) bool { return a < b })
+	assertVectorEquals(t, sorted, []string{"admin", "guest", "user"})
+
+	assertSetEquals(t, collection.DictionaryDistinctValuesSet(roles), []string{"admin", "guest", "user"})
+
+	if empty := collection.DictionaryDistinctValues(collection.DictionaryEmpty[string, int]()); empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+}
+
+func TestDictionaryRebuild(t *testing.T) {
+	stock := collection.DictionarySyncFromMap(map[string]int{
+		"red apple": 3, "green apple": 2, "pear": 4, "yellow apple": 1,
+	})
+
+	fruits := collection.DictionaryRebuild(stock, func(k string, v int) (string, int) {
+		fields := strings.Fields(k)
+		return fields[len(fields)-1], v
+	}, func(existing, incoming int) int {
+		return existing + incoming
+	})
+
+	if fruits.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, fruits.Size())
+	}
+
+	if apples, _ := fruits.Get("apple"); apples != 6 {
+		t.Errorf("Expected %d but got %d", 6, apples)
+	}
+
+	if pears, _ := fruits.Get("pear"); pears != 4 {
+		t.Errorf("Expected %d but got %d", 4, pears)
+	}
+}
+
+func TestDictionaryAll(t *testing.T) {
+	dict := collection.DictionaryFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	collected := map[string]int{}
+	for k, v := range dict.All() {
+		collected[k] = v
+	}
+
+	if len(collected) != 3 || collected["a"] != 1 || collected["b"] != 2 || collected["c"] != 3 {
+		t.Errorf("Expected %v but got %v", dict.Collect(), collected)
+	}
+
+	visited := 0
+	for range dict.All() {
+		visited++
+		break
+	}
+
+	if visited != 1 {
+		t.Errorf("Expected %d but got %d", 1, visited)
+	}
+}
+
+func TestVectorBucketByKey(t *testing.T) {
+	type reading struct {
+		minute int
+		value  int
+	}
+
+	readings := collection.VectorFromList([]reading{
+		{0, 4}, {3, 6}, {5, 1}, {9, 9}, {12, 2}, {14, 8}, {22, 5},
+	})
+
+	type window struct {
+		count int
+		sum   int
+	}
+
+	windows := collection.VectorBucketByKey(readings, func(r reading) int {
+		return r.minute / 10 * 10
+	}, func(int) window {
+		return window{}
+	}, func(acc window, r reading) window {
+		return window{acc.count + 1, acc.sum + r.value}
+	})
+
+	expected := map[int]window{0: {4, 20}, 10: {2, 10}, 20: {1, 5}}
+	if windows.Size() != len(expected) {
+		t.Errorf("Expected %d but got %d", len(expected), windows.Size())
+	}
+
+	total := 0
+	for bucket, aggregate := range expected {
+		if value, _ := windows.Get(bucket); value != aggregate {
+			t.Errorf("Expected %v but got %v", aggregate, value)
+		}
+		total += aggregate.count
+	}
+
+	if total != readings.Size() {
+		t.Errorf("Expected %d but got %d", readings.Size(), total)
+	}
+}
+
+func TestVectorBucketByKeyReferenceAggregate(t *testing.T) {
+	words := collection.VectorFromList([]string{"go", "zig", "gleam", "rust", "odin", "c"})
+
+	letters := collection.VectorBucketByKey(words, func(w string) int {
+		return len(w) % 2
+	}, func(int) map[byte]int {
+		return map[byte]int{}
+	}, func(acc map[byte]int, w string) map[byte]int {
+		acc[w[0]]++
+		return acc
+	})
+
+	even, _ := letters.Get(0)
+	if len(even) != 3 || even['g'] != 1 || even['r'] != 1 || even['o'] != 1 {
+		t.Errorf("Expected %v but got %v", map[byte]int{'g': 1, 'r': 1, 'o': 1}, even)
+	}
+
+	odd, _ := letters.Get(1)
+	if len(odd) != 3 || odd['z'] != 1 || odd['g'] != 1 || odd['c'] != 1 {
+		t.Errorf("Expected %v but got %v", map[byte]int{'z': 1, 'g': 1, 'c': 1}, odd)
+	}
+}
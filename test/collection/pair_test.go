@@ -0,0 +1,95 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestPairSwap(t *testing.T) {
+	pair := collection.NewPair("a", 1)
+
+	swapped := pair.Swap()
+
+	if swapped.Key() != 1 || swapped.Value() != "a" {
+		t.Errorf("Expected (%d, %s) but got (%d, %s)", 1, "a", swapped.Key(), swapped.Value())
+	}
+}
+
+func TestPairSwapFunction(t *testing.T) {
+	pairs := collection.VectorFromList([]collection.Pair[string, int]{
+		collection.NewPair("a", 1),
+		collection.NewPair("b", 2),
+	})
+
+	swapped := collection.VectorMap(pairs, collection.PairSwap[string, int])
+
+	expected := []string{"(1, a)", "(2, b)"}
+	for i, text := range expected {
+		if pair, _ := swapped.Get(i); pair.String() != text {
+			t.Errorf("Expected %s but got %s", text, pair.String())
+		}
+	}
+}
+
+func TestPairString(t *testing.T) {
+	pair := collection.NewPair("a", 1)
+
+	if text := pair.String(); text != "(a, 1)" {
+		t.Errorf("Expected %s but got %s", "(a, 1)", text)
+	}
+
+	if text := fmt.Sprint(collection.NewPair(1.5, []int{1, 2})); text != "(1.5, [1 2])" {
+		t.Errorf("Expected %s but got %s", "(1.5, [1 2])", text)
+	}
+}
+
+func TestPairJSONRoundTrip(t *testing.T) {
+	pair := collection.NewPair("a", 1)
+
+	data, err := json.Marshal(pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `["a",1]` {
+		t.Errorf("Expected %s but got %s", `["a",1]`, data)
+	}
+
+	var result collection.Pair[string, int]
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result != pair {
+		t.Errorf("Expected %s but got %s", pair, result)
+	}
+
+	if err := json.Unmarshal([]byte(`["a",1,2]`), &result); err == nil {
+		t.Error("Expected an error decoding an array of 3 elements")
+	}
+
+	if err := json.Unmarshal([]byte(`[1,1]`), &result); err == nil {
+		t.Error("Expected an error decoding a number into a string key")
+	}
+}
+
+func TestPairMapKey(t *testing.T) {
+	pair := collection.PairMapKey(collection.NewPair("a", 1), strings.ToUpper)
+
+	if pair.Key() != "A" || pair.Value() != 1 {
+		t.Errorf("Expected (%s, %d) but got (%s, %d)", "A", 1, pair.Key(), pair.Value())
+	}
+}
+
+func TestPairMapValue(t *testing.T) {
+	pair := collection.PairMapValue(collection.NewPair("a", 1), strconv.Itoa)
+
+	if pair.Key() != "a" || pair.Value() != "1" {
+		t.Errorf("Expected (%s, %s) but got (%s, %s)", "a", "1", pair.Key(), pair.Value())
+	}
+}
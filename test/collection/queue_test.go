@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestQueueEnqueueDequeue(t *testing.T) {
+	queue := collection.QueueEmpty[int]()
+	queue.Enqueue(1, 2).Enqueue(3)
+
+	if queue.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, queue.Size())
+	}
+
+	for _, expected := range []int{1, 2, 3} {
+		value, exists := queue.Dequeue()
+		if !exists || value != expected {
+			t.Errorf("Expected %d but got %d", expected, value)
+		}
+	}
+
+	if !queue.IsEmpty() {
+		t.Errorf("Expected queue to be empty")
+	}
+}
+
+func TestQueueWrapAround(t *testing.T) {
+	queue := collection.QueueFromList([]int{1, 2, 3})
+
+	queue.Dequeue()
+	queue.Dequeue()
+	queue.Enqueue(4, 5, 6)
+
+	value, exists := queue.PeekFront()
+	if !exists || value != 3 {
+		t.Errorf("Expected %d but got %d", 3, value)
+	}
+
+	for _, expected := range []int{3, 4, 5, 6} {
+		value, exists := queue.Dequeue()
+		if !exists || value != expected {
+			t.Errorf("Expected %d but got %d", expected, value)
+		}
+	}
+}
+
+func TestQueueEmpty(t *testing.T) {
+	queue := collection.QueueEmpty[string]()
+
+	if value, exists := queue.Dequeue(); exists || value != "" {
+		t.Errorf("Expected empty dequeue but got %s", value)
+	}
+
+	if value, exists := queue.PeekFront(); exists || value != "" {
+		t.Errorf("Expected empty peek but got %s", value)
+	}
+}
+
+func TestQueueCyclesDoNotGrow(t *testing.T) {
+	queue := collection.QueueFromList([]int{1, 2, 3, 4})
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		queue.Enqueue(5)
+		queue.Dequeue()
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected %d allocations but got %f", 0, allocs)
+	}
+
+	if queue.Size() != 4 {
+		t.Errorf("Expected %d but got %d", 4, queue.Size())
+	}
+}
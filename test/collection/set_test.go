@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func assertSetEquals[T comparable](t *testing.T, set *collection.Set[T], expected []T) {
+	t.Helper()
+
+	if set.Size() != len(expected) {
+		t.Errorf("Expected %v but got %v", expected, set.ToVector().Collect())
+		return
+	}
+
+	for _, item := range expected {
+		if !set.Contains(item) {
+			t.Errorf("Expected %v but got %v", expected, set.ToVector().Collect())
+			return
+		}
+	}
+}
+
+func TestSetAddRemove(t *testing.T) {
+	set := collection.SetEmpty[int]()
+	set.Add(1, 2, 2, 3)
+
+	assertSetEquals(t, set, []int{1, 2, 3})
+
+	if !set.Remove(2) {
+		t.Error("Expected present element to be removed")
+	}
+
+	if set.Remove(2) {
+		t.Error("Expected missing element to not be removed")
+	}
+
+	assertSetEquals(t, set, []int{1, 3})
+}
+
+func TestSetOperations(t *testing.T) {
+	a := collection.SetFromList([]int{1, 2, 3})
+	b := collection.SetFromList([]int{2, 3, 4})
+
+	assertSetEquals(t, a.Union(b), []int{1, 2, 3, 4})
+	assertSetEquals(t, a.Intersection(b), []int{2, 3})
+	assertSetEquals(t, a.Difference(b), []int{1})
+
+	symmetric := a.Difference(b).Union(b.Difference(a))
+	assertSetEquals(t, symmetric, []int{1, 4})
+
+	assertSetEquals(t, a, []int{1, 2, 3})
+	assertSetEquals(t, b, []int{2, 3, 4})
+}
+
+func TestSetForEach(t *testing.T) {
+	set := collection.SetFromList([]int{1, 2, 3})
+
+	total := 0
+	set.ForEach(func(i int) {
+		total += i
+	})
+
+	if total != 6 {
+		t.Errorf("Expected %d but got %d", 6, total)
+	}
+
+	if vec := set.ToVector(); vec.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, vec.Size())
+	}
+}
@@ -0,0 +1,61 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestStackPushPop(t *testing.T) {
+	stack := collection.StackEmpty[int]()
+	stack.Push(1, 2).Push(3)
+
+	if stack.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, stack.Size())
+	}
+
+	for _, expected := range []int{3, 2, 1} {
+		value, exists := stack.Pop()
+		if !exists || value != expected {
+			t.Errorf("Expected %d but got %d", expected, value)
+		}
+	}
+
+	if !stack.IsEmpty() {
+		t.Errorf("Expected stack to be empty")
+	}
+}
+
+func TestStackFromList(t *testing.T) {
+	stack := collection.StackFromList([]string{"a", "b", "c"})
+
+	value, exists := stack.Peek()
+	if !exists || value != "c" {
+		t.Errorf("Expected %s but got %s", "c", value)
+	}
+
+	if stack.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, stack.Size())
+	}
+}
+
+func TestStackEmpty(t *testing.T) {
+	stack := collection.StackEmpty[int]()
+
+	if value, exists := stack.Pop(); exists || value != 0 {
+		t.Errorf("Expected empty pop but got %d", value)
+	}
+
+	if value, exists := stack.Peek(); exists || value != 0 {
+		t.Errorf("Expected empty peek but got %d", value)
+	}
+
+	stack.Push(1, 2).Clear()
+	if !stack.IsEmpty() {
+		t.Errorf("Expected stack to be empty after Clear")
+	}
+
+	if _, exists := stack.Pop(); exists {
+		t.Errorf("Expected empty pop after Clear")
+	}
+}
@@ -0,0 +1,193 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestVectorCSVRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"name", "note"},
+		{"go", "fast, simple"},
+		{"quote", `she said "hi"`},
+		{"single"},
+	}
+
+	var buffer bytes.Buffer
+	if err := collection.VectorToCSV(collection.VectorFromList(rows), &buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := collection.VectorFromCSV(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Size() != len(rows) {
+		t.Fatalf("Expected %d but got %d", len(rows), result.Size())
+	}
+
+	for i, row := range rows {
+		if record, _ := result.Get(i); !slices.Equal(record, row) {
+			t.Errorf("Expected %v but got %v", row, record)
+		}
+	}
+}
+
+type jsonlRecord struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+func TestVectorJSONLRoundTrip(t *testing.T) {
+	vec := collection.VectorFromList([]jsonlRecord{
+		{"Golang", 30},
+		{"Rust", 25},
+		{"Zig", 40},
+	})
+
+	var buffer bytes.Buffer
+	if err := collection.VectorWriteJSONL(vec, &buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := bytes.Count(buffer.Bytes(), []byte("\n")); lines != 3 {
+		t.Errorf("Expected %d but got %d", 3, lines)
+	}
+
+	result, err := collection.VectorReadJSONL[jsonlRecord](&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVectorEquals(t, result, vec.Collect())
+}
+
+func TestVectorReadJSONLInvalid(t *testing.T) {
+	if _, err := collection.VectorReadJSONL[int](strings.NewReader("1\n\"a\"\n")); err == nil {
+		t.Error("Expected an error decoding a string into an int")
+	}
+}
+
+func TestVectorJSONRoundTrip(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	data, err := json.Marshal(vec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected %s but got %s", "[1,2,3]", data)
+	}
+
+	result := collection.VectorEmpty[int]()
+	if err := json.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+
+	assertVectorEquals(t, result, vec.Collect())
+
+	if data, _ := json.Marshal(collection.VectorEmpty[int]()); string(data) != "[]" {
+		t.Errorf("Expected %s but got %s", "[]", data)
+	}
+}
+
+func TestVectorJSONNested(t *testing.T) {
+	type document struct {
+		Records *collection.Vector[jsonlRecord]             `json:"records"`
+		Matrix  *collection.Vector[*collection.Vector[int]] `json:"matrix"`
+	}
+
+	source := document{
+		Records: collection.VectorFromList([]jsonlRecord{{"Golang", 30}, {"Zig", 40}}),
+		Matrix: collection.VectorFromList([]*collection.Vector[int]{
+			collection.VectorFromList([]int{1, 2}),
+			collection.VectorFromList([]int{3}),
+		}),
+	}
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result document
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	assertVectorEquals(t, result.Records, source.Records.Collect())
+
+	if result.Matrix.Size() != 2 {
+		t.Fatalf("Expected %d but got %d", 2, result.Matrix.Size())
+	}
+
+	first, _ := result.Matrix.Get(0)
+	second, _ := result.Matrix.Get(1)
+	assertVectorEquals(t, first, []int{1, 2})
+	assertVectorEquals(t, second, []int{3})
+
+	if err := json.Unmarshal([]byte(`{"records": 1}`), &result); err == nil {
+		t.Error("Expected an error decoding a number into a Vector")
+	}
+}
+
+func encodePoint(w io.Writer, p [2]int16) error {
+	return binary.Write(w, binary.BigEndian, p)
+}
+
+func decodePoint(r io.Reader) ([2]int16, error) {
+	var p [2]int16
+	err := binary.Read(r, binary.BigEndian, &p)
+	return p, err
+}
+
+func TestVectorEncodeRoundTrip(t *testing.T) {
+	vec := collection.VectorFromList([][2]int16{{1, -2}, {300, 4}, {-5, 6}})
+
+	var buffer bytes.Buffer
+	if err := collection.VectorEncode(vec, &buffer, encodePoint); err != nil {
+		t.Fatal(err)
+	}
+
+	if size := buffer.Len(); size != 8+3*4 {
+		t.Errorf("Expected %d but got %d", 8+3*4, size)
+	}
+
+	result, err := collection.VectorDecode(&buffer, decodePoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVectorEquals(t, result, vec.Collect())
+
+	buffer.Reset()
+	if err := collection.VectorEncode(collection.VectorEmpty[[2]int16](), &buffer, encodePoint); err != nil {
+		t.Fatal(err)
+	}
+
+	if empty, err := collection.VectorDecode(&buffer, decodePoint); err != nil || empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+}
+
+func TestVectorDecodeTruncated(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := collection.VectorEncode(collection.VectorFromList([][2]int16{{1, 2}, {3, 4}}), &buffer, encodePoint); err != nil {
+		t.Fatal(err)
+	}
+
+	truncated := bytes.NewReader(buffer.Bytes()[:buffer.Len()-2])
+	if _, err := collection.VectorDecode(truncated, decodePoint); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected %v but got %v", io.ErrUnexpectedEOF, err)
+	}
+}
@@ -1,7 +1,17 @@
 package collection
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/Rafael24595/go-collections/collection"
 )
@@ -50,9 +60,31 @@ func TestVectorRemove(t *testing.T) {
 		t.Errorf("Vector does not contains %d but it is added.", 2)
 	}
 
-	if len := vector.Size(); len < 2 {
+	if len := vector.Size(); len != 2 {
 		t.Errorf("Expected %d but got %d", 2, len)
 	}
+
+	assertVectorEquals(t, vector, []int{1, 3})
+
+	if _, ok := vector.Remove(2); ok {
+		t.Error("Expected out of range remove to fail")
+	}
+}
+
+func TestVectorRemoveIf(t *testing.T) {
+	vector := collection.VectorFromList([]int{
+		1, 2, 3, 4, 5, 6,
+	})
+
+	if removed := vector.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != 3 {
+		t.Errorf("Expected %d but got %d", 3, removed)
+	}
+
+	assertVectorEquals(t, vector, []int{1, 3, 5})
+
+	if removed := vector.RemoveIf(func(v int) bool { return v > 10 }); removed != 0 {
+		t.Errorf("Expected %d but got %d", 0, removed)
+	}
 }
 
 func TestVectorShift(t *testing.T) {
@@ -96,21 +128,23 @@ func TestVectorAppendIfAbsent(t *testing.T) {
 		0, 1, 2, 3,
 	})
 
-	vector.AppendIfAbsent(func(i1, i2 int) bool {
-		return i1 != i2
-	}, 2)
+	equals := func(i1, i2 int) bool {
+		return i1 == i2
+	}
+
+	vector.AppendIfAbsent(equals, 2)
 
-	if vector.Size() > 4 {
+	if vector.Size() != 4 {
 		t.Errorf("Vector size is %d but %d expected", vector.Size(), 4)
 	}
 
-	vector.AppendIfAbsent(func(i1, i2 int) bool {
-		return i1 != i2
-	}, 4, 5)
+	vector.AppendIfAbsent(equals, 4, 5, 4)
 
-	if vector.Size() > 6 {
+	if vector.Size() != 6 {
 		t.Errorf("Vector size is %d but %d expected", vector.Size(), 6)
 	}
+
+	assertVectorEquals(t, vector, []int{0, 1, 2, 3, 4, 5})
 }
 
 func TestVectorMax(t *testing.T) {
@@ -226,3 +260,1087 @@ func TestVectorMinEmpty(t *testing.T) {
 		t.Fatal("expected ok == false")
 	}
 }
+
+func TestVectorSortedIndexOf(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 3, 5, 7, 9})
+
+	cases := map[int]int{1: 0, 5: 2, 9: 4}
+	for target, expected := range cases {
+		if index, ok := collection.VectorSortedIndexOf(vec, target, cmp.Compare[int]); !ok || index != expected {
+			t.Errorf("Expected %d but got %d", expected, index)
+		}
+	}
+
+	for _, target := range []int{0, 4, 10} {
+		if index, ok := collection.VectorSortedIndexOf(vec, target, cmp.Compare[int]); ok || index != -1 {
+			t.Errorf("Expected %d but got %d", -1, index)
+		}
+	}
+
+	empty := collection.VectorEmpty[int]()
+	if index, ok := collection.VectorSortedIndexOf(empty, 1, cmp.Compare[int]); ok || index != -1 {
+		t.Errorf("Expected %d but got %d", -1, index)
+	}
+}
+
+func TestVectorFoldInto(t *testing.T) {
+	counts := collection.DictionaryFromMap(map[string]int{"a": 10, "c": 5})
+	vec := collection.VectorFromList([]string{"a", "b", "a", "b", "b"})
+
+	collection.VectorFoldInto(vec, counts, func(s string) string {
+		return s
+	}, func(existing int, exists bool, item string) int {
+		return existing + 1
+	})
+
+	expected := map[string]int{"a": 12, "b": 3, "c": 5}
+	for key, count := range expected {
+		if result, ok := counts.Get(key); !ok || result != count {
+			t.Errorf("Expected %d but got %d", count, result)
+		}
+	}
+}
+
+func TestVectorCollectCopyCap(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	items := vec.CollectCopyCap(2)
+
+	if len(items) != 3 {
+		t.Errorf("Expected %d but got %d", 3, len(items))
+	}
+
+	if cap(items) != 5 {
+		t.Errorf("Expected %d but got %d", 5, cap(items))
+	}
+
+	for i, v := range []int{1, 2, 3} {
+		if items[i] != v {
+			t.Errorf("Expected %d but got %d", v, items[i])
+		}
+	}
+
+	items[0] = 9
+	if value, _ := vec.Get(0); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+}
+
+func TestVectorMapAccum(t *testing.T) {
+	vec := collection.VectorFromList([]string{"a", "b", "c"})
+
+	next, labeled := collection.VectorMapAccum(vec, 1, func(id int, s string) (int, string) {
+		return id + 1, fmt.Sprintf("%d:%s", id, s)
+	})
+
+	if next != 4 {
+		t.Errorf("Expected %d but got %d", 4, next)
+	}
+
+	for i, expected := range []string{"1:a", "2:b", "3:c"} {
+		if result, _ := labeled.Get(i); result != expected {
+			t.Errorf("Expected %s but got %s", expected, result)
+		}
+	}
+}
+
+func TestVectorPairsUnzip(t *testing.T) {
+	vec := collection.VectorFromList([]collection.Pair[string, int]{
+		collection.NewPair("a", 1),
+		collection.NewPair("b", 2),
+		collection.NewPair("c", 3),
+	})
+
+	keys, values := collection.VectorPairsUnzip(vec)
+
+	if len(keys) != 3 || len(values) != 3 {
+		t.Fatalf("Expected %d but got (%d, %d)", 3, len(keys), len(values))
+	}
+
+	for i, pair := range vec.Collect() {
+		if keys[i] != pair.Key() || values[i] != pair.Value() {
+			t.Errorf("Expected (%s, %d) but got (%s, %d)", pair.Key(), pair.Value(), keys[i], values[i])
+		}
+	}
+
+	keys, values = collection.VectorPairsUnzip(collection.VectorEmpty[collection.Pair[string, int]]())
+	if len(keys) != 0 || len(values) != 0 {
+		t.Errorf("Expected %d but got (%d, %d)", 0, len(keys), len(values))
+	}
+}
+
+func TestVectorCollectExactly(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	items, ok := vec.CollectExactly(3)
+	if !ok || len(items) != 3 {
+		t.Errorf("Expected %d but got %d", 3, len(items))
+	}
+
+	items, ok = vec.CollectExactly(2)
+	if ok || items != nil {
+		t.Errorf("Expected nil but got %v", items)
+	}
+
+	items, ok = vec.CollectExactly(4)
+	if ok || items != nil {
+		t.Errorf("Expected nil but got %v", items)
+	}
+}
+
+func TestVectorTakeMatching(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	calls := 0
+	evens := vec.TakeMatching(2, func(v int) bool {
+		calls++
+		return v%2 == 0
+	})
+
+	if evens.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, evens.Size())
+	}
+
+	if calls != 4 {
+		t.Errorf("Expected %d but got %d", 4, calls)
+	}
+
+	large := vec.TakeMatching(10, func(v int) bool {
+		return v > 6
+	})
+
+	if large.Size() != 2 {
+		t.Errorf("Expected %d but got %d", 2, large.Size())
+	}
+}
+
+func assertVectorEquals[T comparable](t *testing.T, vec *collection.Vector[T], expected []T) {
+	t.Helper()
+
+	if vec.Size() != len(expected) {
+		t.Errorf("Expected %v but got %v", expected, vec.Collect())
+		return
+	}
+
+	for i, item := range expected {
+		if result, _ := vec.Get(i); result != item {
+			t.Errorf("Expected %v but got %v", expected, vec.Collect())
+			return
+		}
+	}
+}
+
+func TestVectorDiff(t *testing.T) {
+	added, removed, common := collection.VectorDiff(
+		collection.VectorFromList([]int{1, 2}),
+		collection.VectorFromList([]int{3, 4}),
+	)
+
+	assertVectorEquals(t, added, []int{3, 4})
+	assertVectorEquals(t, removed, []int{1, 2})
+	assertVectorEquals(t, common, []int{})
+
+	added, removed, common = collection.VectorDiff(
+		collection.VectorFromList([]int{1, 2, 2}),
+		collection.VectorFromList([]int{2, 1, 2}),
+	)
+
+	assertVectorEquals(t, added, []int{})
+	assertVectorEquals(t, removed, []int{})
+	assertVectorEquals(t, common, []int{2, 1, 2})
+
+	added, removed, common = collection.VectorDiff(
+		collection.VectorFromList([]int{1, 2, 2, 3}),
+		collection.VectorFromList([]int{2, 3, 4}),
+	)
+
+	assertVectorEquals(t, added, []int{4})
+	assertVectorEquals(t, removed, []int{1, 2})
+	assertVectorEquals(t, common, []int{2, 3})
+}
+
+func TestVectorTee(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	var left, right *collection.Vector[int]
+	vec.Tee(func(v *collection.Vector[int]) {
+		left = v
+		v.Map(func(_, i int) int { return i * 10 })
+	}, func(v *collection.Vector[int]) {
+		right = v
+		v.Append(4)
+	})
+
+	if left == vec || right == vec || left == right {
+		t.Fatal("Expected each callback to receive an independent copy")
+	}
+
+	assertVectorEquals(t, vec, []int{1, 2, 3})
+	assertVectorEquals(t, left, []int{10, 20, 30})
+	assertVectorEquals(t, right, []int{1, 2, 3, 4})
+}
+
+func TestVectorCopyWithin(t *testing.T) {
+	forward := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+	forward.CopyWithin(1, 0, 3)
+	assertVectorEquals(t, forward, []int{1, 1, 2, 3, 5})
+
+	backward := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+	backward.CopyWithin(0, 2, 5)
+	assertVectorEquals(t, backward, []int{3, 4, 5, 4, 5})
+
+	truncated := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+	truncated.CopyWithin(3, 0, 5)
+	assertVectorEquals(t, truncated, []int{1, 2, 3, 1, 2})
+
+	clamped := collection.VectorFromList([]int{1, 2, 3})
+	clamped.CopyWithin(-1, 1, 10)
+	assertVectorEquals(t, clamped, []int{2, 3, 3})
+}
+
+func TestVectorSlidingReduce(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+
+	sum := func(window []int) int {
+		total := 0
+		for _, v := range window {
+			total += v
+		}
+		return total
+	}
+
+	assertVectorEquals(t, collection.VectorSlidingReduce(vec, 3, sum), []int{6, 9, 12})
+	assertVectorEquals(t, collection.VectorSlidingReduce(vec, 5, sum), []int{15})
+	assertVectorEquals(t, collection.VectorSlidingReduce(vec, 6, sum), []int{})
+	assertVectorEquals(t, collection.VectorSlidingReduce(vec, 0, sum), []int{})
+}
+
+func TestVectorHistogram(t *testing.T) {
+	vec := collection.VectorFromList([]float64{-5, 0, 9.9, 10, 15, 20, 100})
+
+	counts := collection.VectorHistogram(vec, []float64{0, 10, 20})
+
+	expected := []int{1, 2, 2, 2}
+	if len(counts) != len(expected) {
+		t.Fatalf("Expected %v but got %v", expected, counts)
+	}
+
+	for i, count := range expected {
+		if counts[i] != count {
+			t.Errorf("Expected %v but got %v", expected, counts)
+		}
+	}
+
+	if counts := collection.VectorHistogram(vec, []float64{10, 0}); counts != nil {
+		t.Errorf("Expected nil but got %v", counts)
+	}
+}
+
+func TestVectorReplaceSub(t *testing.T) {
+	pattern := collection.VectorFromList([]int{1, 2})
+	replacement := collection.VectorFromList([]int{9, 9, 9})
+
+	multiple := collection.VectorFromList([]int{1, 2, 3, 1, 2, 4})
+	assertVectorEquals(t, collection.VectorReplaceSub(multiple, pattern, replacement), []int{9, 9, 9, 3, 9, 9, 9, 4})
+	assertVectorEquals(t, multiple, []int{1, 2, 3, 1, 2, 4})
+
+	adjacent := collection.VectorFromList([]int{1, 2, 1, 2})
+	assertVectorEquals(t, collection.VectorReplaceSub(adjacent, pattern, collection.VectorEmpty[int]()), []int{})
+
+	overlapping := collection.VectorFromList([]int{1, 1, 1})
+	assertVectorEquals(t, collection.VectorReplaceSub(overlapping, collection.VectorFromList([]int{1, 1}), collection.VectorFromList([]int{0})), []int{0, 1})
+
+	none := collection.VectorFromList([]int{2, 1, 3})
+	assertVectorEquals(t, collection.VectorReplaceSub(none, pattern, replacement), []int{2, 1, 3})
+
+	assertVectorEquals(t, collection.VectorReplaceSub(none, collection.VectorEmpty[int](), replacement), []int{2, 1, 3})
+}
+
+func TestVectorDedupWindow(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 1, 2, 3, 1, 2, 2, 4, 5, 6, 2})
+
+	assertVectorEquals(t, collection.VectorDedupWindow(vec, 2), []int{1, 2, 3, 1, 2, 4, 5, 6, 2})
+	assertVectorEquals(t, collection.VectorDedupWindow(vec, 3), []int{1, 2, 3, 4, 5, 6, 2})
+	assertVectorEquals(t, collection.VectorDedupWindow(vec, 100), []int{1, 2, 3, 4, 5, 6})
+	assertVectorEquals(t, collection.VectorDedupWindow(vec, 0), vec.Collect())
+}
+
+func TestVectorSliceBounds(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+
+	full := vec.Slice(0, vec.Size())
+	assertVectorEquals(t, full, []int{1, 2, 3, 4, 5})
+
+	full.Set(0, 9)
+	if value, _ := vec.Get(0); value != 1 {
+		t.Errorf("Expected %d but got %d", 1, value)
+	}
+
+	assertVectorEquals(t, vec.Slice(vec.Size(), vec.Size()), []int{})
+	assertVectorEquals(t, vec.Slice(-3, 2), []int{1, 2})
+	assertVectorEquals(t, vec.Slice(3, 10), []int{4, 5})
+	assertVectorEquals(t, vec.Slice(4, 2), []int{})
+	assertVectorEquals(t, vec.Slice(6, 10), []int{})
+
+	head := vec.Slice(0, 2)
+	head.Append(7)
+	assertVectorEquals(t, vec, []int{1, 2, 3, 4, 5})
+
+	assertVectorEquals(t, vec.Clone().SliceSelf(0, vec.Size()), []int{1, 2, 3, 4, 5})
+	assertVectorEquals(t, vec.Clone().SliceSelf(-1, 1), []int{1})
+}
+
+func TestVectorDistinct(t *testing.T) {
+	vec := collection.VectorFromList([]int{3, 1, 3, 2, 1})
+
+	assertVectorEquals(t, collection.VectorDistinct(vec), []int{3, 1, 2})
+	assertVectorEquals(t, vec, []int{3, 1, 3, 2, 1})
+
+	duplicates := collection.VectorFromList([]int{7, 7, 7, 7})
+	assertVectorEquals(t, collection.VectorDistinct(duplicates), []int{7})
+}
+
+func TestVectorDistinctBy(t *testing.T) {
+	vec := collection.VectorFromList([]LangTest{
+		{"Golang", 30},
+		{"Rust", 25},
+		{"Zig", 30},
+		{"C", 25},
+		{"Odin", 10},
+	})
+
+	distinct := collection.VectorDistinctBy(vec, func(l LangTest) int {
+		return l.score
+	})
+
+	assertVectorEquals(t, distinct, []LangTest{{"Golang", 30}, {"Rust", 25}, {"Odin", 10}})
+
+	if vec.Size() != 5 {
+		t.Errorf("Expected %d but got %d", 5, vec.Size())
+	}
+}
+
+func TestVectorForEachParallelErr(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, -2, 3, -4, 5, -6, 7})
+	errNegative := errors.New("negative value")
+
+	var calls atomic.Int32
+	errs := vec.ForEachParallelErr(3, func(i, v int) error {
+		calls.Add(1)
+		if v < 0 {
+			return fmt.Errorf("%w: %d", errNegative, v)
+		}
+		return nil
+	})
+
+	if calls.Load() != 7 {
+		t.Errorf("Expected %d but got %d", 7, calls.Load())
+	}
+
+	expected := []int{1, 3, 5}
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d but got %d", len(expected), len(errs))
+	}
+
+	for i, err := range errs {
+		var indexed collection.IndexedError
+		if !errors.As(err, &indexed) {
+			t.Fatalf("Expected an IndexedError but got %T", err)
+		}
+		if indexed.Index != expected[i] {
+			t.Errorf("Expected %d but got %d", expected[i], indexed.Index)
+		}
+		if !errors.Is(err, errNegative) {
+			t.Errorf("Expected error to wrap %v", errNegative)
+		}
+	}
+}
+
+func TestVectorForEachParallelErrNoErrors(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	errs := vec.ForEachParallelErr(0, func(i, v int) error {
+		return nil
+	})
+
+	if errs != nil {
+		t.Errorf("Expected nil but got %v", errs)
+	}
+}
+
+func TestVectorTopK(t *testing.T) {
+	items := []int{42, 7, 19, 88, 3, 56, 19, 71, 0, 64, 25, 93, 11}
+	vec := collection.VectorFromList(items)
+	less := func(a, b int) bool { return a < b }
+
+	for _, k := range []int{1, 3, 5, 13} {
+		sorted := collection.VectorFromList(items).Clone().Sort(func(i, j int) bool {
+			return i > j
+		}).Collect()
+
+		assertVectorEquals(t, collection.VectorTopK(vec, k, less), sorted[:k])
+	}
+
+	assertVectorEquals(t, vec, items)
+}
+
+func TestVectorTopKBounds(t *testing.T) {
+	vec := collection.VectorFromList([]int{2, 9, 4})
+	less := func(a, b int) bool { return a < b }
+
+	assertVectorEquals(t, collection.VectorTopK(vec, 10, less), []int{9, 4, 2})
+	assertVectorEquals(t, collection.VectorTopK(vec, 0, less), []int{})
+	assertVectorEquals(t, collection.VectorTopK(collection.VectorEmpty[int](), 2, less), []int{})
+}
+
+func TestVectorStableShuffle(t *testing.T) {
+	identity := func(s string) string { return s }
+
+	a := collection.VectorFromList([]string{"alice", "bob", "carol", "dave", "erin", "frank"})
+	b := collection.VectorFromList([]string{"frank", "carol", "erin", "alice", "dave", "bob"})
+
+	shuffledA := collection.VectorStableShuffle(a, identity, 42)
+	shuffledB := collection.VectorStableShuffle(b, identity, 42)
+
+	assertVectorEquals(t, shuffledB, shuffledA.Collect())
+	assertVectorEquals(t, collection.VectorStableShuffle(a, identity, 42), shuffledA.Collect())
+	assertVectorEquals(t, a, []string{"alice", "bob", "carol", "dave", "erin", "frank"})
+
+	if shuffledA.Size() != a.Size() {
+		t.Errorf("Expected %d but got %d", a.Size(), shuffledA.Size())
+	}
+}
+
+func TestVectorStableShuffleSeed(t *testing.T) {
+	identity := func(s string) string { return s }
+	vec := collection.VectorFromList([]string{"a", "b", "c", "d", "e", "f", "g", "h"})
+
+	first := collection.VectorStableShuffle(vec, identity, 1).Join(",")
+	for seed := uint64(2); seed < 10; seed++ {
+		if collection.VectorStableShuffle(vec, identity, seed).Join(",") != first {
+			return
+		}
+	}
+
+	t.Errorf("Expected different seeds to produce different orders but always got %s", first)
+}
+
+func TestVectorPartition(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6})
+
+	even, odd := vec.Partition(func(v int) bool {
+		return v%2 == 0
+	})
+
+	assertVectorEquals(t, even, []int{2, 4, 6})
+	assertVectorEquals(t, odd, []int{1, 3, 5})
+	assertVectorEquals(t, vec, []int{1, 2, 3, 4, 5, 6})
+}
+
+func TestVectorPartitionStableSelf(t *testing.T) {
+	vec := collection.VectorFromList([]LangTest{
+		{"Rust", 3}, {"Golang", 9}, {"Zig", 2}, {"Java", 8}, {"Odin", 1}, {"Python", 7},
+	})
+
+	vec.PartitionStableSelf(func(l LangTest) bool {
+		return l.score > 5
+	})
+
+	expected := []string{"Golang", "Java", "Python", "Rust", "Zig", "Odin"}
+	for i, name := range expected {
+		if lang, _ := vec.Get(i); lang.name != name {
+			t.Errorf("Expected %s but got %s", name, lang.name)
+		}
+	}
+
+	none := collection.VectorFromList([]int{1, 2, 3})
+	none.PartitionStableSelf(func(v int) bool { return v > 10 })
+	assertVectorEquals(t, none, []int{1, 2, 3})
+}
+
+func TestVectorPercentile(t *testing.T) {
+	vec := collection.VectorFromList([]int{50, 15, 40, 20, 35})
+
+	cases := []struct {
+		p        float64
+		expected float64
+	}{
+		{0, 15},
+		{50, 35},
+		{100, 50},
+		{40, 29},
+		{90, 46},
+	}
+
+	for _, c := range cases {
+		result, ok := collection.VectorPercentile(vec, c.p)
+		if !ok || math.Abs(result-c.expected) > 1e-9 {
+			t.Errorf("Expected %f but got %f", c.expected, result)
+		}
+	}
+
+	assertVectorEquals(t, vec, []int{50, 15, 40, 20, 35})
+
+	if _, ok := collection.VectorPercentile(vec, 101); ok {
+		t.Errorf("Expected a percentile above 100 to be rejected")
+	}
+
+	if _, ok := collection.VectorPercentile(vec, math.NaN()); ok {
+		t.Errorf("Expected a NaN percentile to be rejected")
+	}
+
+	if _, ok := collection.VectorPercentile(collection.VectorEmpty[int](), 50); ok {
+		t.Errorf("Expected an empty vector to be rejected")
+	}
+}
+
+func TestVectorMedian(t *testing.T) {
+	odd, _ := collection.VectorMedian(collection.VectorFromList([]float64{3, 1, 2}))
+	if odd != 2 {
+		t.Errorf("Expected %f but got %f", 2.0, odd)
+	}
+
+	even, _ := collection.VectorMedian(collection.VectorFromList([]int{7, 1, 4, 3}))
+	if even != 3.5 {
+		t.Errorf("Expected %f but got %f", 3.5, even)
+	}
+
+	if _, ok := collection.VectorMedian(collection.VectorEmpty[int]()); ok {
+		t.Errorf("Expected an empty vector to be rejected")
+	}
+}
+
+func TestVectorInsert(t *testing.T) {
+	vec := collection.VectorFromList([]int{3, 5})
+
+	if _, ok := vec.Insert(0, 1, 2); !ok {
+		t.Errorf("Expected insertion at %d to succeed", 0)
+	}
+	assertVectorEquals(t, vec, []int{1, 2, 3, 5})
+
+	vec.Insert(3, 4)
+	assertVectorEquals(t, vec, []int{1, 2, 3, 4, 5})
+
+	vec.Insert(vec.Size(), 6, 7)
+	assertVectorEquals(t, vec, []int{1, 2, 3, 4, 5, 6, 7})
+
+	if _, ok := vec.Insert(-1, 0); ok {
+		t.Errorf("Expected insertion at %d to fail", -1)
+	}
+
+	if _, ok := vec.Insert(vec.Size()+1, 8); ok {
+		t.Errorf("Expected insertion at %d to fail", vec.Size()+1)
+	}
+	assertVectorEquals(t, vec, []int{1, 2, 3, 4, 5, 6, 7})
+}
+
+func TestVectorEqualFloat(t *testing.T) {
+	a := collection.VectorFromList([]float64{0.1 + 0.2, 1.0, -2.5})
+	b := collection.VectorFromList([]float64{0.3, 1.0, -2.5})
+
+	if !collection.VectorEqualFloat(a, b, 1e-9) {
+		t.Errorf("Expected vectors to be equal within tolerance")
+	}
+
+	c := collection.VectorFromList([]float64{0.3, 1.001, -2.5})
+	if collection.VectorEqualFloat(a, c, 1e-9) {
+		t.Errorf("Expected vectors to differ outside tolerance")
+	}
+
+	if !collection.VectorEqualFloat(a, c, 1e-2) {
+		t.Errorf("Expected vectors to be equal within a wider tolerance")
+	}
+
+	d := collection.VectorFromList([]float64{0.3, 1.0})
+	if collection.VectorEqualFloat(a, d, 1) {
+		t.Errorf("Expected vectors of different lengths to differ")
+	}
+
+	nan := collection.VectorFromList([]float64{math.NaN()})
+	if collection.VectorEqualFloat(nan, nan, 1) {
+		t.Errorf("Expected NaN to never be equal")
+	}
+}
+
+func TestVectorSwap(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	if !vec.Swap(0, 2) {
+		t.Errorf("Expected swap of %d and %d to succeed", 0, 2)
+	}
+	assertVectorEquals(t, vec, []int{3, 2, 1})
+
+	if !vec.Swap(1, 1) {
+		t.Errorf("Expected swap of %d with itself to succeed", 1)
+	}
+	assertVectorEquals(t, vec, []int{3, 2, 1})
+
+	if vec.Swap(0, 3) || vec.Swap(-1, 0) {
+		t.Errorf("Expected swaps with out of range indices to fail")
+	}
+	assertVectorEquals(t, vec, []int{3, 2, 1})
+}
+
+func TestVectorStreamTo(t *testing.T) {
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+	vec := collection.VectorFromList(items)
+
+	out := make(chan int, 2)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(out)
+		errs <- vec.StreamTo(context.Background(), out)
+	}()
+
+	received := []int{}
+	for v := range out {
+		if len(received)%10 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		received = append(received, v)
+	}
+
+	if err := <-errs; err != nil {
+		t.Errorf("Expected nil but got %v", err)
+	}
+
+	assertVectorEquals(t, collection.VectorFromList(received), items)
+}
+
+func TestVectorStreamToCancel(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan int, 1)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- vec.StreamTo(ctx, out)
+	}()
+
+	if v := <-out; v != 1 {
+		t.Errorf("Expected %d but got %d", 1, v)
+	}
+	cancel()
+
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("Expected %v but got %v", context.Canceled, err)
+	}
+}
+
+func TestVectorCount(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	evens := vec.Count(func(v int) bool {
+		return v%2 == 0
+	})
+
+	if evens != 5 {
+		t.Errorf("Expected %d but got %d", 5, evens)
+	}
+
+	if vec.CountAll() != 10 {
+		t.Errorf("Expected %d but got %d", 10, vec.CountAll())
+	}
+
+	if count := collection.VectorEmpty[int]().Count(func(int) bool { return true }); count != 0 {
+		t.Errorf("Expected %d but got %d", 0, count)
+	}
+}
+
+func TestVectorAnyAll(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	mixed := collection.VectorFromList([]int{1, 2, 3})
+	if !mixed.Any(even) || mixed.All(even) {
+		t.Errorf("Expected Any to be true and All to be false")
+	}
+
+	evens := collection.VectorFromList([]int{2, 4, 6})
+	if !evens.Any(even) || !evens.All(even) {
+		t.Errorf("Expected Any and All to be true")
+	}
+
+	odds := collection.VectorFromList([]int{1, 3})
+	if odds.Any(even) || odds.All(even) {
+		t.Errorf("Expected Any and All to be false")
+	}
+
+	empty := collection.VectorEmpty[int]()
+	if empty.Any(even) || !empty.All(even) {
+		t.Errorf("Expected Any to be false and All to be true on an empty vector")
+	}
+}
+
+func TestVectorVariance(t *testing.T) {
+	vec := collection.VectorFromList([]int{2, 4, 4, 4, 5, 5, 7, 9})
+
+	variance, ok := collection.VectorVariance(vec)
+	if !ok || math.Abs(variance-32.0/7.0) > 1e-9 {
+		t.Errorf("Expected %f but got %f", 32.0/7.0, variance)
+	}
+
+	stddev, ok := collection.VectorStdDev(collection.VectorFromList([]float64{1, 2, 3, 4}))
+	if !ok || math.Abs(stddev-math.Sqrt(5.0/3.0)) > 1e-9 {
+		t.Errorf("Expected %f but got %f", math.Sqrt(5.0/3.0), stddev)
+	}
+
+	shifted := collection.VectorFromList([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+	variance, _ = collection.VectorVariance(shifted)
+	if math.Abs(variance-30) > 1e-6 {
+		t.Errorf("Expected %f but got %f", 30.0, variance)
+	}
+}
+
+func TestVectorVarianceTooFewElements(t *testing.T) {
+	if _, ok := collection.VectorVariance(collection.VectorFromList([]int{5})); ok {
+		t.Errorf("Expected a single element vector to be rejected")
+	}
+
+	if _, ok := collection.VectorStdDev(collection.VectorEmpty[float64]()); ok {
+		t.Errorf("Expected an empty vector to be rejected")
+	}
+}
+
+func TestVectorFlatMap(t *testing.T) {
+	vec := collection.VectorFromList([]string{"go,rust", "", "zig", "c,odin,v"})
+
+	tokens := collection.VectorFlatMap(vec, func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return strings.Split(s, ",")
+	})
+
+	assertVectorEquals(t, tokens, []string{"go", "rust", "zig", "c", "odin", "v"})
+}
+
+func TestVectorZip(t *testing.T) {
+	keys := collection.VectorFromList([]string{"a", "b", "c"})
+	values := collection.VectorFromList([]int{1, 2})
+
+	zipped := collection.VectorZip(keys, values)
+	assertVectorEquals(t, zipped, []collection.Pair[string, int]{
+		collection.NewPair("a", 1),
+		collection.NewPair("b", 2),
+	})
+
+	empty := collection.VectorZip(keys, collection.VectorEmpty[int]())
+	if empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+}
+
+func TestVectorZipWith(t *testing.T) {
+	a := collection.VectorFromList([]int{1, 2, 3})
+	b := collection.VectorFromList([]int{10, 20, 30, 40})
+
+	sums := collection.VectorZipWith(a, b, func(x, y int) int {
+		return x + y
+	})
+	assertVectorEquals(t, sums, []int{11, 22, 33})
+
+	labels := collection.VectorZipWith(b, a, func(x, y int) string {
+		return fmt.Sprintf("%d-%d", x, y)
+	})
+	assertVectorEquals(t, labels, []string{"10-1", "20-2", "30-3"})
+}
+
+func TestVectorChunk(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5, 6, 7})
+
+	chunks := vec.Chunk(3)
+	if len(chunks) != 3 {
+		t.Fatalf("Expected %d but got %d", 3, len(chunks))
+	}
+	assertVectorEquals(t, chunks[0], []int{1, 2, 3})
+	assertVectorEquals(t, chunks[1], []int{4, 5, 6})
+	assertVectorEquals(t, chunks[2], []int{7})
+
+	exact := collection.VectorFromList([]int{1, 2, 3, 4}).Chunk(2)
+	if len(exact) != 2 {
+		t.Fatalf("Expected %d but got %d", 2, len(exact))
+	}
+	assertVectorEquals(t, exact[1], []int{3, 4})
+
+	if len(vec.Chunk(0)) != 0 {
+		t.Errorf("Expected no chunks for a non positive size")
+	}
+
+	if len(collection.VectorEmpty[int]().Chunk(3)) != 0 {
+		t.Errorf("Expected no chunks for an empty vector")
+	}
+}
+
+func TestVectorMergePtr(t *testing.T) {
+	a := collection.VectorFromList([]int{1, 2, 3})
+	b := collection.VectorFromList([]int{4, 5, 6})
+
+	a.MergePtr(b)
+	assertVectorEquals(t, a, []int{1, 2, 3, 4, 5, 6})
+	assertVectorEquals(t, b, []int{4, 5, 6})
+
+	b.Set(0, 40)
+	b.Append(7)
+	assertVectorEquals(t, a, []int{1, 2, 3, 4, 5, 6})
+
+	a.MergePtr(a)
+	assertVectorEquals(t, a, []int{1, 2, 3, 4, 5, 6, 1, 2, 3, 4, 5, 6})
+}
+
+func TestVectorSumMinMax(t *testing.T) {
+	ints := collection.VectorFromList([]int{4, -2, 9, 1})
+
+	if sum := collection.VectorSum(ints); sum != 12 {
+		t.Errorf("Expected %d but got %d", 12, sum)
+	}
+
+	if smallest, ok := collection.VectorMin(ints); !ok || smallest != -2 {
+		t.Errorf("Expected %d but got %d", -2, smallest)
+	}
+
+	if largest, ok := collection.VectorMax(ints); !ok || largest != 9 {
+		t.Errorf("Expected %d but got %d", 9, largest)
+	}
+
+	floats := collection.VectorFromList([]float64{2.5, -1.25, 4})
+
+	if sum := collection.VectorSum(floats); sum != 5.25 {
+		t.Errorf("Expected %f but got %f", 5.25, sum)
+	}
+
+	if smallest, ok := collection.VectorMin(floats); !ok || smallest != -1.25 {
+		t.Errorf("Expected %f but got %f", -1.25, smallest)
+	}
+
+	if largest, ok := collection.VectorMax(floats); !ok || largest != 4 {
+		t.Errorf("Expected %f but got %f", 4.0, largest)
+	}
+}
+
+func TestVectorSumMinMaxEmpty(t *testing.T) {
+	empty := collection.VectorEmpty[int]()
+
+	if sum := collection.VectorSum(empty); sum != 0 {
+		t.Errorf("Expected %d but got %d", 0, sum)
+	}
+
+	if _, ok := collection.VectorMin(empty); ok {
+		t.Errorf("Expected an empty vector to have no minimum")
+	}
+
+	if _, ok := collection.VectorMax(empty); ok {
+		t.Errorf("Expected an empty vector to have no maximum")
+	}
+}
+
+func TestVectorSortedDistinct(t *testing.T) {
+	vec := collection.VectorFromList([]int{5, 3, 1, 3, 5, 2, 1})
+
+	assertVectorEquals(t, collection.VectorSortedDistinct(vec), []int{1, 2, 3, 5})
+	assertVectorEquals(t, vec, []int{5, 3, 1, 3, 5, 2, 1})
+
+	sorted := collection.VectorFromList([]string{"a", "b", "b", "c"})
+	assertVectorEquals(t, collection.VectorSortedDistinct(sorted), []string{"a", "b", "c"})
+
+	assertVectorEquals(t, collection.VectorSortedDistinct(collection.VectorEmpty[int]()), []int{})
+}
+
+func TestVectorMaxByMinBy(t *testing.T) {
+	vec := collection.VectorFromList([]string{"go", "rust", "c", "java", "zig", "d"})
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+
+	if longest, ok := collection.VectorMaxBy(vec, byLength); !ok || longest != "rust" {
+		t.Errorf("Expected %s but got %s", "rust", longest)
+	}
+
+	if shortest, ok := collection.VectorMinBy(vec, byLength); !ok || shortest != "c" {
+		t.Errorf("Expected %s but got %s", "c", shortest)
+	}
+
+	empty := collection.VectorEmpty[string]()
+	if _, ok := collection.VectorMaxBy(empty, byLength); ok {
+		t.Errorf("Expected an empty vector to have no maximum")
+	}
+
+	if _, ok := collection.VectorMinBy(empty, byLength); ok {
+		t.Errorf("Expected an empty vector to have no minimum")
+	}
+}
+
+func TestVectorArgMaxArgMin(t *testing.T) {
+	vec := collection.VectorFromList([]int{3, 9, 1, 9, 4, 1})
+	less := func(a, b int) bool { return a < b }
+
+	if index, ok := collection.VectorArgMax(vec, less); !ok || index != 1 {
+		t.Errorf("Expected %d but got %d", 1, index)
+	}
+
+	if index, ok := collection.VectorArgMin(vec, less); !ok || index != 2 {
+		t.Errorf("Expected %d but got %d", 2, index)
+	}
+
+	empty := collection.VectorEmpty[int]()
+	if index, ok := collection.VectorArgMax(empty, less); ok || index != -1 {
+		t.Errorf("Expected %d but got %d", -1, index)
+	}
+
+	if index, ok := collection.VectorArgMin(empty, less); ok || index != -1 {
+		t.Errorf("Expected %d but got %d", -1, index)
+	}
+}
+
+func TestVectorLastIndexOf(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 3, 2, 3, 1})
+
+	if index := vec.LastIndexOf(func(v int) bool { return v == 3 }); index != 3 {
+		t.Errorf("Expected %d but got %d", 3, index)
+	}
+
+	if index := vec.LastIndexOf(func(v int) bool { return v == 1 }); index != 4 {
+		t.Errorf("Expected %d but got %d", 4, index)
+	}
+
+	if index := vec.LastIndexOf(func(v int) bool { return v == 5 }); index != -1 {
+		t.Errorf("Expected %d but got %d", -1, index)
+	}
+}
+
+func TestVectorIndexOfAll(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 3, 2, 3, 1, 3})
+
+	indices := vec.IndexOfAll(func(v int) bool { return v == 3 })
+	assertVectorEquals(t, collection.VectorFromList(indices), []int{1, 3, 5})
+
+	missing := vec.IndexOfAll(func(v int) bool { return v == 5 })
+	if missing == nil || len(missing) != 0 {
+		t.Errorf("Expected an empty non-nil slice but got %v", missing)
+	}
+}
+
+func TestVectorMovingAverage(t *testing.T) {
+	vec := collection.VectorFromList([]int{4, 8, 15, 16, 23, 42, 7, 1})
+
+	for window := 1; window <= vec.Size(); window++ {
+		naive := collection.VectorSlidingReduce(vec, window, func(items []int) float64 {
+			sum := 0
+			for _, item := range items {
+				sum += item
+			}
+			return float64(sum) / float64(len(items))
+		})
+
+		if !collection.VectorEqualFloat(collection.VectorMovingAverage(vec, window), naive, 1e-9) {
+			t.Errorf("Expected %v for window %d", naive.Collect(), window)
+		}
+	}
+
+	averages := collection.VectorMovingAverage(collection.VectorFromList([]float64{1, 2, 3, 4, 5}), 3)
+	assertVectorEquals(t, averages, []float64{2, 3, 4})
+}
+
+func TestVectorMovingAverageBounds(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	if empty := collection.VectorMovingAverage(vec, 0); empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+
+	if averages := collection.VectorMovingAverage(vec, 4); averages.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, averages.Size())
+	}
+}
+
+func TestVectorReservoirSample(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	short := collection.VectorReservoirSample(slices.Values([]int{1, 2}), 5, r)
+	assertVectorEquals(t, short, []int{1, 2})
+
+	if empty := collection.VectorReservoirSample(slices.Values([]int{1, 2}), 0, r); empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+
+	const (
+		stream = 10
+		k      = 3
+		trials = 30000
+	)
+
+	items := make([]int, stream)
+	for i := range items {
+		items[i] = i
+	}
+
+	counts := make([]int, stream)
+	for range trials {
+		sample := collection.VectorReservoirSample(slices.Values(items), k, r)
+		if sample.Size() != k {
+			t.Fatalf("Expected %d but got %d", k, sample.Size())
+		}
+		sample.ForEach(func(_ int, v int) {
+			counts[v]++
+		})
+	}
+
+	expected := trials * k / stream
+	tolerance := expected / 20
+	for i, count := range counts {
+		if count < expected-tolerance || count > expected+tolerance {
+			t.Errorf("Expected %d ± %d for element %d but got %d", expected, tolerance, i, count)
+		}
+	}
+}
+
+func TestVectorIterators(t *testing.T) {
+	vec := collection.VectorFromList([]int{3, 1, 2})
+
+	indexes := []int{}
+	values := []int{}
+	for i, v := range vec.Enumerate() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	if !slices.Equal(indexes, []int{0, 1, 2}) || !slices.Equal(values, []int{3, 1, 2}) {
+		t.Errorf("Expected %v but got %v", []int{3, 1, 2}, values)
+	}
+
+	sorted := slices.Sorted(vec.Values())
+	if !slices.Equal(sorted, []int{1, 2, 3}) {
+		t.Errorf("Expected %v but got %v", []int{1, 2, 3}, sorted)
+	}
+
+	assertVectorEquals(t, vec, []int{3, 1, 2})
+}
+
+func TestVectorIteratorsBreakEarly(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+
+	visited := 0
+	for _, v := range vec.Enumerate() {
+		visited++
+		if v == 2 {
+			break
+		}
+	}
+
+	if visited != 2 {
+		t.Errorf("Expected %d but got %d", 2, visited)
+	}
+
+	visited = 0
+	for v := range vec.Values() {
+		visited++
+		if v == 3 {
+			break
+		}
+	}
+
+	if visited != 3 {
+		t.Errorf("Expected %d but got %d", 3, visited)
+	}
+}
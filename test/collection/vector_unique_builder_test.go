@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestVectorUniqueBuilder(t *testing.T) {
+	builder := collection.VectorUniqueBuilderEmpty[int]()
+	builder.Add(3, 1, 3, 2).Add(1, 4)
+
+	vec := builder.Build()
+	assertVectorEquals(t, vec, []int{3, 1, 2, 4})
+
+	if !builder.Contains(4) || builder.Contains(5) {
+		t.Error("Expected builder to contain 4 and not 5")
+	}
+
+	builder.Add(5)
+
+	assertVectorEquals(t, vec, []int{3, 1, 2, 4})
+
+	if builder.Size() != 5 {
+		t.Errorf("Expected %d but got %d", 5, builder.Size())
+	}
+}
+
+func BenchmarkVectorUniqueBuilderAdd(b *testing.B) {
+	for b.Loop() {
+		builder := collection.VectorUniqueBuilderEmpty[int]()
+		for i := range 2000 {
+			builder.Add(i % 1000)
+		}
+		builder.Build()
+	}
+}
+
+func BenchmarkVectorAppendIfAbsent(b *testing.B) {
+	equals := func(i, j int) bool {
+		return i == j
+	}
+
+	for b.Loop() {
+		vec := collection.VectorEmpty[int]()
+		for i := range 2000 {
+			vec.AppendIfAbsent(equals, i%1000)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/Rafael24595/go-collections/collection"
+)
+
+func TestVectorViewAliasesParent(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3, 4, 5})
+	view := vec.View(1, 4)
+
+	if view.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, view.Size())
+	}
+
+	vec.Set(2, 9)
+
+	if value, exists := view.Get(1); !exists || value != 9 {
+		t.Errorf("Expected %d but got %d", 9, value)
+	}
+
+	detached := view.Materialize()
+	vec.Set(2, 7)
+
+	assertVectorEquals(t, detached, []int{2, 9, 4})
+
+	if value, _ := view.Get(1); value != 7 {
+		t.Errorf("Expected %d but got %d", 7, value)
+	}
+}
+
+func TestVectorViewBounds(t *testing.T) {
+	vec := collection.VectorFromList([]int{1, 2, 3})
+
+	view := vec.View(-2, 10)
+	if view.Size() != 3 {
+		t.Errorf("Expected %d but got %d", 3, view.Size())
+	}
+
+	if _, exists := view.Get(3); exists {
+		t.Errorf("Expected index %d to be out of bounds", 3)
+	}
+
+	empty := vec.View(2, 1)
+	if empty.Size() != 0 {
+		t.Errorf("Expected %d but got %d", 0, empty.Size())
+	}
+
+	sum := 0
+	vec.View(1, 3).ForEach(func(i, v int) {
+		sum += v
+	})
+
+	if sum != 5 {
+		t.Errorf("Expected %d but got %d", 5, sum)
+	}
+}